@@ -0,0 +1,176 @@
+package firehydrant
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type cachedReadsKey struct{}
+
+// WithCachedReads marks the GET requests made with ctx as cacheable. When the client's read
+// cache is enabled their responses are kept for the lifetime of the client, and identical
+// requests made while one is in flight wait for its response instead of being sent again.
+// Data sources read with it, since many of them repeat the same lookups within a single plan
+// and can share the responses.
+func WithCachedReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cachedReadsKey{}, true)
+}
+
+func cachedReads(ctx context.Context) bool {
+	cached, _ := ctx.Value(cachedReadsKey{}).(bool)
+	return cached
+}
+
+// WithReadCache enables the read cache used by requests made with WithCachedReads
+func WithReadCache(enabled bool) OptFunc {
+	return func(c *APIClient) error {
+		c.readCache = enabled
+		return nil
+	}
+}
+
+// cachedResponse is a response whose body has been read, so it can be served repeatedly
+type cachedResponse struct {
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// cacheCall is a request in flight that identical requests wait for
+type cacheCall struct {
+	done     chan struct{}
+	response *cachedResponse
+	err      error
+}
+
+// cacheTransport serves cacheable GET requests from memory. Successful responses are kept
+// until the client makes any other kind of request, since a create, update or delete may
+// change what a lookup returns. It sits above the retry transport, so a cached response
+// costs nothing against the rate limit.
+type cacheTransport struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+	calls   map[string]*cacheCall
+	// generation changes on every invalidation, so a response that was in flight while
+	// the cache was cleared isn't stored
+	generation uint64
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidate()
+		return t.next.RoundTrip(req)
+	}
+	if !cachedReads(req.Context()) {
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	for {
+		t.mu.Lock()
+		if entry, ok := t.entries[key]; ok {
+			t.mu.Unlock()
+			tflog.SubsystemTrace(newLogSubsystem(req.Context()), LogSubsystem, "Serving API response from the read cache", map[string]interface{}{
+				"http_method": req.Method,
+				"http_url":    redactURL(req.URL),
+			})
+			return entry.response(req), nil
+		}
+		call, ok := t.calls[key]
+		if !ok {
+			// Still holding the lock, this request becomes the one the others wait for
+			break
+		}
+		t.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.err != nil {
+			// A request cancelled by its own caller says nothing about this one, which
+			// sends the request itself if nothing else has in the meantime
+			if (errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded)) && req.Context().Err() == nil {
+				continue
+			}
+			return nil, call.err
+		}
+		return call.response.response(req), nil
+	}
+
+	call := &cacheCall{done: make(chan struct{})}
+	if t.calls == nil {
+		t.calls = map[string]*cacheCall{}
+	}
+	t.calls[key] = call
+	generation := t.generation
+	t.mu.Unlock()
+
+	call.response, call.err = t.roundTrip(req)
+
+	t.mu.Lock()
+	delete(t.calls, key)
+	if call.err == nil && call.response.statusCode >= 200 && call.response.statusCode <= 299 && generation == t.generation {
+		if t.entries == nil {
+			t.entries = map[string]*cachedResponse{}
+		}
+		t.entries[key] = call.response
+	}
+	t.mu.Unlock()
+	close(call.done)
+
+	if call.err != nil {
+		return nil, call.err
+	}
+	return call.response.response(req), nil
+}
+
+// roundTrip sends the request and reads the whole response, so it can be shared
+func (t *cacheTransport) roundTrip(req *http.Request) (*cachedResponse, error) {
+	response, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedResponse{
+		status:     response.Status,
+		statusCode: response.StatusCode,
+		header:     response.Header,
+		body:       body,
+	}, nil
+}
+
+func (t *cacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = nil
+	t.generation++
+}
@@ -0,0 +1,233 @@
+package firehydrant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func usersHandler(requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method != http.MethodGet {
+			w.Write([]byte(`{}`))
+			return
+		}
+		if req.URL.Query().Get("query") == "missing@example.com" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "not found"}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "user-1", "name": "Jane", "email": "` + req.URL.Query().Get("query") + `"}]}`))
+	}
+}
+
+func TestReadCache(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(usersHandler(&requests))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithReadCache(true))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	ctx := WithCachedReads(context.Background())
+
+	for i := 0; i < 3; i++ {
+		users, err := c.GetUsers(ctx, GetUserParams{Query: "jane@example.com"})
+		if err != nil {
+			t.Fatalf("Received error getting users: %s", err.Error())
+		}
+		if len(users.Users) != 1 || users.Users[0].ID != "user-1" {
+			t.Fatalf("Expected the cached response to be decoded, got %+v", users.Users)
+		}
+	}
+	if expected, got := int32(1), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+
+	// A different query is a different cache entry
+	if _, err := c.GetUsers(ctx, GetUserParams{Query: "john@example.com"}); err != nil {
+		t.Fatalf("Received error getting users: %s", err.Error())
+	}
+	if expected, got := int32(2), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+
+	// Requests without WithCachedReads always reach the API
+	if _, err := c.GetUsers(context.Background(), GetUserParams{Query: "jane@example.com"}); err != nil {
+		t.Fatalf("Received error getting users: %s", err.Error())
+	}
+	if expected, got := int32(3), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+
+	// Errors aren't cached
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetUsers(ctx, GetUserParams{Query: "missing@example.com"}); !IsNotFound(err) {
+			t.Fatalf("Expected a not found error, got %v", err)
+		}
+	}
+	if expected, got := int32(5), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
+
+func TestReadCacheInvalidatedByWrites(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(usersHandler(&requests))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithReadCache(true))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	ctx := WithCachedReads(context.Background())
+
+	if _, err := c.GetUsers(ctx, GetUserParams{Query: "jane@example.com"}); err != nil {
+		t.Fatalf("Received error getting users: %s", err.Error())
+	}
+	if _, err := c.client(ctx).Post("users").Receive(nil, nil); err != nil {
+		t.Fatalf("Received error posting: %s", err.Error())
+	}
+	if _, err := c.GetUsers(ctx, GetUserParams{Query: "jane@example.com"}); err != nil {
+		t.Fatalf("Received error getting users: %s", err.Error())
+	}
+	if expected, got := int32(3), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
+
+func TestReadCacheDeduplicatesConcurrentRequests(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+		usersHandler(&requests)(w, req)
+	}))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithReadCache(true))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	ctx := WithCachedReads(context.Background())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetUsers(ctx, GetUserParams{Query: "jane@example.com"})
+			errs <- err
+		}()
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Received error getting users: %s", err.Error())
+		}
+	}
+	if expected, got := int32(1), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
+
+func TestReadCacheDisabled(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(usersHandler(&requests))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	ctx := WithCachedReads(context.Background())
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetUsers(ctx, GetUserParams{Query: "jane@example.com"}); err != nil {
+			t.Fatalf("Received error getting users: %s", err.Error())
+		}
+	}
+	if expected, got := int32(2), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
+
+// cancelledFirstTransport holds the first request until it's cancelled and answers the rest
+type cancelledFirstTransport struct {
+	requests int32
+	started  chan struct{}
+}
+
+func (t *cancelledFirstTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if atomic.AddInt32(&t.requests, 1) == 1 {
+		close(t.started)
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+// waitingContext closes waiting once a request made with it waits for its cancellation
+type waitingContext struct {
+	context.Context
+	waiting chan struct{}
+	once    sync.Once
+}
+
+func (c *waitingContext) Done() <-chan struct{} {
+	c.once.Do(func() { close(c.waiting) })
+	return c.Context.Done()
+}
+
+func TestReadCacheRetriesAfterCancelledRequest(t *testing.T) {
+	next := &cancelledFirstTransport{started: make(chan struct{})}
+	transport := &cacheTransport{next: next}
+
+	leaderCtx, cancel := context.WithCancel(WithCachedReads(context.Background()))
+	defer cancel()
+	leaderErr := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequestWithContext(leaderCtx, http.MethodGet, "https://api.example.com/v1/users", nil)
+		_, err := transport.RoundTrip(req)
+		leaderErr <- err
+	}()
+	<-next.started
+
+	waiterCtx := &waitingContext{Context: WithCachedReads(context.Background()), waiting: make(chan struct{})}
+	waiterErr := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequestWithContext(waiterCtx, http.MethodGet, "https://api.example.com/v1/users", nil)
+		response, err := transport.RoundTrip(req)
+		if err == nil && response.StatusCode != http.StatusOK {
+			err = fmt.Errorf("unexpected status %d", response.StatusCode)
+		}
+		waiterErr <- err
+	}()
+	<-waiterCtx.waiting
+	cancel()
+
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the cancelled request to fail with %v, got %v", context.Canceled, err)
+	}
+	// The waiting request didn't inherit the cancellation, it sent the request itself
+	if err := <-waiterErr; err != nil {
+		t.Fatalf("Received error from the waiting request: %s", err.Error())
+	}
+	if expected, got := int32(2), atomic.LoadInt32(&next.requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
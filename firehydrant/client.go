@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	fhsdk "github.com/firehydrant/firehydrant-go-sdk"
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 
 	"github.com/dghubble/sling"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -20,20 +22,14 @@ const (
 )
 
 // checkResponseStatusCode checks to see if the response's status
-// code corresponds to an error or not. An error is returned for
+// code corresponds to an error or not. An *Error is returned for
 // all status codes 300 and above
 func checkResponseStatusCode(response *http.Response, apiError *APIError) error {
-	switch code := response.StatusCode; {
-	case code >= 200 && code <= 299:
+	if code := response.StatusCode; code >= 200 && code <= 299 {
 		return nil
-	case code == 404:
-		req := response.Request
-		return fmt.Errorf("%w: %s '%s'", ErrorNotFound, req.Method, req.URL.String())
-	case code == 401:
-		return fmt.Errorf("%s\n%s", ErrorUnauthorized, apiError)
-	default:
-		return fmt.Errorf("%d request failed with error\n%s", code, apiError)
 	}
+
+	return newError(response, apiError)
 }
 
 // APIClient is the client that accesses all of the api.firehydrant.io resources
@@ -41,8 +37,15 @@ type APIClient struct {
 	baseURL         string
 	token           string
 	userAgentSuffix string
-
-	Sdk *fhsdk.FireHydrant
+	maxRetries      int
+	retryMaxWait    time.Duration
+	limiter         *rate.Limiter
+	transport       transportConfig
+	defaultLabels   map[string]string
+	readCache       bool
+
+	httpClient *http.Client
+	Sdk        *fhsdk.FireHydrant
 }
 
 const (
@@ -73,12 +76,37 @@ type Client interface {
 }
 
 type transportWithUserAgent struct {
+	next      http.RoundTripper
 	userAgent string
 }
 
 func (t *transportWithUserAgent) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("User-Agent", t.userAgent)
-	return http.DefaultTransport.RoundTrip(req)
+	return t.next.RoundTrip(req)
+}
+
+// contextDoer sends requests with a context, which sling can't attach to the requests it builds
+type contextDoer struct {
+	ctx    context.Context
+	client *http.Client
+}
+
+func (d contextDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.client.Do(req.WithContext(d.ctx))
+}
+
+// rateLimitTransport blocks each request until the shared token bucket allows it, so
+// concurrent resources cannot exceed the configured request rate between them
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
 }
 
 // OptFunc is a function that sets a setting on a client
@@ -99,6 +127,44 @@ func WithUserAgentSuffix(suffix string) OptFunc {
 	}
 }
 
+// WithMaxRetries sets how many times a rate limited or failed request is retried
+func WithMaxRetries(maxRetries int) OptFunc {
+	return func(c *APIClient) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must not be negative, got %d", maxRetries)
+		}
+		c.maxRetries = maxRetries
+		return nil
+	}
+}
+
+// WithRetryMaxWait sets the longest the client will wait between two attempts of a request
+func WithRetryMaxWait(maxWait time.Duration) OptFunc {
+	return func(c *APIClient) error {
+		if maxWait <= 0 {
+			return fmt.Errorf("retry max wait must be positive, got %s", maxWait)
+		}
+		c.retryMaxWait = maxWait
+		return nil
+	}
+}
+
+// WithRequestsPerSecond limits the rate of requests sent to the API across all clients.
+// A value of zero disables client side rate limiting.
+func WithRequestsPerSecond(rps float64) OptFunc {
+	return func(c *APIClient) error {
+		if rps < 0 {
+			return fmt.Errorf("requests per second must not be negative, got %v", rps)
+		}
+		if rps == 0 {
+			c.limiter = nil
+			return nil
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(rps), max(int(rps), 1))
+		return nil
+	}
+}
+
 // NewRestClient initializes a new API client for FireHydrant
 func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 	firehydrantBaseURL := os.Getenv("FIREHYDRANT_BASE_URL")
@@ -107,8 +173,10 @@ func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 	}
 
 	c := &APIClient{
-		baseURL: firehydrantBaseURL,
-		token:   token,
+		baseURL:      firehydrantBaseURL,
+		token:        token,
+		maxRetries:   DefaultMaxRetries,
+		retryMaxWait: DefaultRetryMaxWait,
 	}
 
 	for _, f := range opts {
@@ -117,17 +185,33 @@ func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 		}
 	}
 
-	//init speakeasy client also
-	httpClient := &http.Client{Transport: &transportWithUserAgent{
-		userAgent: fmt.Sprintf("%s (%s)/%s", UserAgentPrefix, GetBuildInfo().String(), c.userAgentSuffix)},
+	// Both the sling client and the speakeasy client share this http client, so they
+	// get the same User-Agent, rate limit, retry, proxy and TLS behaviour
+	var transport http.RoundTripper = &transportWithUserAgent{
+		next:      c.transport.newTransport(),
+		userAgent: fmt.Sprintf("%s (%s)/%s", UserAgentPrefix, GetBuildInfo().String(), c.userAgentSuffix),
+	}
+	transport = &loggingTransport{next: transport, token: token}
+	if c.limiter != nil {
+		// Retries go through the limiter too, so they count against the same budget
+		transport = &rateLimitTransport{next: transport, limiter: c.limiter}
 	}
+	transport = &retryTransport{
+		next:       transport,
+		maxRetries: c.maxRetries,
+		maxWait:    c.retryMaxWait,
+	}
+	if c.readCache {
+		transport = &cacheTransport{next: transport}
+	}
+	c.httpClient = &http.Client{Transport: transport}
 
 	// speakeasy sdk will only work with v1 of the api and adds this to each path automatically.  The server URL then assumes no path information
 	// Thus, we need to strip any trailing 'v1/' from the base URL provided to configure the old client.
-	firehydrantServerURL := strings.TrimSuffix(firehydrantBaseURL, "v1/")
+	firehydrantServerURL := strings.TrimSuffix(c.baseURL, "v1/")
 
 	c.Sdk = fhsdk.New(
-		fhsdk.WithClient(httpClient),
+		fhsdk.WithClient(c.httpClient),
 		fhsdk.WithServerURL(firehydrantServerURL),
 		fhsdk.WithSecurity(components.Security{
 			APIKey: token,
@@ -137,10 +221,12 @@ func NewRestClient(token string, opts ...OptFunc) (*APIClient, error) {
 	return c, nil
 }
 
-func (c *APIClient) client() *sling.Sling {
+// client returns a sling client whose requests are bound to ctx, so they are cancelled
+// and stop retrying once the resource's timeout expires
+func (c *APIClient) client(ctx context.Context) *sling.Sling {
 	bi := GetBuildInfo()
 
-	return sling.New().Base(c.baseURL).
+	return sling.New().Doer(contextDoer{ctx: ctx, client: c.httpClient}).Base(c.baseURL).
 		Set(
 			"User-Agent",
 			fmt.Sprintf(
@@ -157,7 +243,7 @@ func (c *APIClient) client() *sling.Sling {
 func (c *APIClient) Ping(ctx context.Context) (*PingResponse, error) {
 	pingResponse := &PingResponse{}
 	apiError := &APIError{}
-	response, err := c.client().Get("ping").Receive(pingResponse, apiError)
+	response, err := c.client(ctx).Get("ping").Receive(pingResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not ping")
 	}
@@ -185,6 +271,11 @@ func (c *APIClient) ServiceDependencies() ServiceDependenciesClient {
 	return &RESTServiceDependenciesClient{client: c}
 }
 
+// Priorities returns a PrioritiesClient interface for listing priorities in FireHydrant
+func (c *APIClient) Priorities() PrioritiesClient {
+	return &RESTPrioritiesClient{client: c}
+}
+
 // Severities returns a SeveritiesClient interface for interacting with severities in FireHydrant
 func (c *APIClient) Severities() SeveritiesClient {
 	return &RESTSeveritiesClient{client: c}
@@ -218,7 +309,7 @@ func (c *APIClient) StatusUpdateTemplates() StatusUpdateTemplates {
 func (c *APIClient) GetUsers(ctx context.Context, params GetUserParams) (*UserResponse, error) {
 	userResponse := &UserResponse{}
 	apiError := &APIError{}
-	response, err := c.client().Get("users").QueryStruct(params).Receive(userResponse, apiError)
+	response, err := c.client(ctx).Get("users").QueryStruct(params).Receive(userResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get users")
 	}
@@ -8,7 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/bxcodec/faker/v3"
 	"github.com/stretchr/testify/assert"
@@ -126,3 +128,34 @@ func TestClientInitialization(t *testing.T) {
 		t.Fatalf("Expected %s, Got: %s for actor email", expected, actorEmail)
 	}
 }
+
+func TestClientRequestsPerSecond(t *testing.T) {
+	var requests int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(pingResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("testing-123", WithBaseURL(ts.URL), WithRequestsPerSecond(20))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	// The bucket starts full, so the first 20 requests go through immediately and the
+	// next 10 have to wait for tokens to refill at 20 per second.
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if _, err := c.Ping(context.TODO()); err != nil {
+			t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("Expected requests to be rate limited, 30 requests took %s", elapsed)
+	}
+	if expected, got := int32(30), atomic.LoadInt32(&requests); expected != got {
+		t.Fatalf("Expected %d requests, got %d", expected, got)
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 )
 
 var (
@@ -12,6 +15,149 @@ var (
 	ErrorUnauthorized = errors.New("unauthorized")
 )
 
+// requestIDHeader is the response header the API uses to identify a request in its logs
+const requestIDHeader = "X-Request-Id"
+
+// Error is a failed response from the FireHydrant API, regardless of whether the request
+// was made with the REST client or the SDK
+type Error struct {
+	StatusCode int
+	RequestID  string
+	Method     string
+	URL        string
+	APIError   APIError
+}
+
+var _ error = &Error{}
+
+func newError(response *http.Response, apiError *APIError) *Error {
+	e := &Error{
+		StatusCode: response.StatusCode,
+		RequestID:  response.Header.Get(requestIDHeader),
+	}
+	if apiError != nil {
+		e.APIError = *apiError
+	}
+	if req := response.Request; req != nil {
+		e.Method = req.Method
+		e.URL = req.URL.String()
+	}
+
+	return e
+}
+
+func (e *Error) Error() string {
+	var msg string
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		msg = fmt.Sprintf("%s: %s '%s'", ErrorNotFound, e.Method, e.URL)
+	case http.StatusUnauthorized:
+		msg = fmt.Sprintf("%s\n%s", ErrorUnauthorized, e.APIError)
+	default:
+		msg = fmt.Sprintf("%d request failed with error\n%s", e.StatusCode, e.APIError)
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf("\nrequest id: %s", e.RequestID)
+	}
+
+	return msg
+}
+
+// Is allows errors.Is(err, ErrorNotFound) and errors.Is(err, ErrorUnauthorized) to keep
+// working for callers that predate Error
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrorNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrorUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	default:
+		return false
+	}
+}
+
+// AsError extracts an Error from err. Errors returned by the SDK, both SDKError and the
+// ErrorEntity of validation failures, are converted so that callers can inspect both kinds
+// of client errors the same way.
+func AsError(err error) (*Error, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	var sdkErr *sdkerrors.SDKError
+	if errors.As(err, &sdkErr) {
+		apiError := &APIError{}
+		if sdkErr.Body != "" {
+			if err := json.Unmarshal([]byte(sdkErr.Body), apiError); err != nil {
+				apiError = &APIError{Error: sdkErr.Body}
+			}
+		}
+
+		if sdkErr.RawResponse != nil {
+			return newError(sdkErr.RawResponse, apiError), true
+		}
+		return &Error{StatusCode: sdkErr.StatusCode, APIError: *apiError}, true
+	}
+
+	var entity *sdkerrors.ErrorEntity
+	if errors.As(err, &entity) {
+		// The error entity doesn't carry the status code. The SDK only decodes it from 400
+		// responses, apart from the 409s of the incident change event endpoints, which the
+		// provider doesn't call.
+		apiError := APIError{Messages: entity.Messages}
+		if entity.Detail != nil {
+			apiError.Detail = *entity.Detail
+		}
+		if entity.Code != nil {
+			apiError.Error = *entity.Code
+		}
+		return &Error{StatusCode: http.StatusBadRequest, APIError: apiError}, true
+	}
+
+	return nil, false
+}
+
+func hasStatusCode(err error, codes ...int) bool {
+	apiErr, ok := AsError(err)
+	if !ok {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNotFound returns true if err is a 404 from the API
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if err is a 409 from the API
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited returns true if err is a 429 from the API
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsValidation returns true if the API rejected the request body, which it signals
+// with either a 400 or a 422
+func IsValidation(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
 type APIError struct {
 	Error    string   `json:"error"`
 	Detail   string   `json:"detail"`
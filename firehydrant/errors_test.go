@@ -1,8 +1,17 @@
 package firehydrant
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 )
 
 func TestErrors(t *testing.T) {
@@ -198,3 +207,105 @@ func TestErrors_String(t *testing.T) {
 		}
 	})
 }
+
+func TestErrorHelpers(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		code, _ := strconv.Atoi(strings.TrimPrefix(req.URL.Path, "/severities/"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(code)
+		w.Write([]byte(`{"error":"test error","detail":"test detail"}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL), WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	cases := []struct {
+		code       int
+		notFound   bool
+		conflict   bool
+		limited    bool
+		validation bool
+	}{
+		{http.StatusNotFound, true, false, false, false},
+		{http.StatusConflict, false, true, false, false},
+		{http.StatusTooManyRequests, false, false, true, false},
+		{http.StatusBadRequest, false, false, false, true},
+		{http.StatusUnprocessableEntity, false, false, false, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(strconv.Itoa(tc.code), func(t *testing.T) {
+			_, restErr := c.Severities().Get(context.Background(), strconv.Itoa(tc.code))
+			sdkErr := sdkerrors.NewSDKError("API error occurred", tc.code, `{"error":"test error","detail":"test detail"}`, nil)
+
+			for name, err := range map[string]error{"rest": restErr, "sdk": sdkErr} {
+				if got := IsNotFound(err); got != tc.notFound {
+					t.Errorf("%s: IsNotFound expected %t, got %t", name, tc.notFound, got)
+				}
+				if got := IsConflict(err); got != tc.conflict {
+					t.Errorf("%s: IsConflict expected %t, got %t", name, tc.conflict, got)
+				}
+				if got := IsRateLimited(err); got != tc.limited {
+					t.Errorf("%s: IsRateLimited expected %t, got %t", name, tc.limited, got)
+				}
+				if got := IsValidation(err); got != tc.validation {
+					t.Errorf("%s: IsValidation expected %t, got %t", name, tc.validation, got)
+				}
+
+				apiErr, ok := AsError(err)
+				if !ok {
+					t.Fatalf("%s: expected an *Error, got %T", name, err)
+				}
+				if apiErr.APIError.Detail != "test detail" {
+					t.Errorf("%s: unexpected detail: %s", name, apiErr.APIError.Detail)
+				}
+			}
+
+			apiErr, _ := AsError(restErr)
+			if expected := "req-123"; apiErr.RequestID != expected {
+				t.Errorf("unexpected request id\nexpected: %s\ngot: %s", expected, apiErr.RequestID)
+			}
+			if expected := http.MethodGet; apiErr.Method != expected {
+				t.Errorf("unexpected method\nexpected: %s\ngot: %s", expected, apiErr.Method)
+			}
+			if got := errors.Is(restErr, ErrorNotFound); got != tc.notFound {
+				t.Errorf("errors.Is(err, ErrorNotFound) expected %t, got %t", tc.notFound, got)
+			}
+		})
+	}
+
+	t.Run("sdk error entity", func(t *testing.T) {
+		detail := "test detail"
+		err := fmt.Errorf("creating service: %w", &sdkerrors.ErrorEntity{Detail: &detail, Messages: []string{"name is taken"}})
+
+		if !IsValidation(err) {
+			t.Errorf("expected IsValidation to be true")
+		}
+		if IsNotFound(err) || IsConflict(err) || IsRateLimited(err) {
+			t.Errorf("expected the error entity to only be a validation error")
+		}
+
+		apiErr, ok := AsError(err)
+		if !ok {
+			t.Fatalf("expected an *Error, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("unexpected status code: %d", apiErr.StatusCode)
+		}
+		if apiErr.APIError.Detail != detail {
+			t.Errorf("unexpected detail: %s", apiErr.APIError.Detail)
+		}
+		if len(apiErr.APIError.Messages) != 1 || apiErr.APIError.Messages[0] != "name is taken" {
+			t.Errorf("unexpected messages: %v", apiErr.APIError.Messages)
+		}
+	})
+
+	if IsNotFound(errors.New("resource not found")) {
+		t.Errorf("expected plain errors not to be treated as API errors")
+	}
+}
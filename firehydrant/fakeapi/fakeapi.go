@@ -0,0 +1,492 @@
+// Package fakeapi is an in-memory stand-in for the FireHydrant API. It implements the
+// endpoints the provider uses closely enough that resources can be created, read, updated,
+// imported and deleted in tests without network access or a real organization.
+//
+//	server := fakeapi.NewServer()
+//	defer server.Close()
+//
+//	provider "firehydrant" {
+//	  api_key              = "fake"
+//	  firehydrant_base_url = server.BaseURL()
+//	}
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 200
+)
+
+// Server is a running fake FireHydrant API. The zero value is not usable, create one with
+// NewServer.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	lastID      int
+	collections map[string]*collection
+	resources   []*resource
+	mux         *http.ServeMux
+	// requests counts the requests received by method and path, e.g. "GET /v1/users"
+	requests map[string]int
+}
+
+// collection holds the objects stored under a single collection path, e.g.
+// /v1/teams/<team id>/signal_rules
+type collection struct {
+	objects map[string]map[string]interface{}
+	// order keeps objects in creation order so pages are stable
+	order []string
+}
+
+// NewServer starts a fake API server with no objects in it
+func NewServer() *Server {
+	return newServer(httptest.NewServer)
+}
+
+// NewTLSServer starts a fake API served over HTTPS with a self-signed certificate, see
+// httptest.Server.Certificate
+func NewTLSServer() *Server {
+	return newServer(httptest.NewTLSServer)
+}
+
+func newServer(start func(handler http.Handler) *httptest.Server) *Server {
+	s := &Server{
+		collections: map[string]*collection{},
+		mux:         http.NewServeMux(),
+		requests:    map[string]int{},
+	}
+
+	s.mux.HandleFunc("GET /v1/ping", func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"actor": map[string]interface{}{
+				"id":    "00000000-0000-4000-8000-000000000000",
+				"name":  "Fake API",
+				"email": "fake-api@firehydrant.io",
+				"type":  "firehydrant_user",
+			},
+		})
+	})
+	for _, r := range resources {
+		s.register(r)
+	}
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		writeError(w, http.StatusNotFound, "Not Found", fmt.Sprintf("no route for %s %s", req.Method, req.URL.Path))
+	})
+
+	s.Server = start(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL is the value to configure as the provider's firehydrant_base_url
+func (s *Server) BaseURL() string {
+	return s.URL + "/v1/"
+}
+
+// Seed stores objects in the collection at collectionPath (e.g. /v1/users) as if they had
+// been created through the API and returns them with their generated IDs. It's meant for
+// objects the provider only reads, such as users.
+func (s *Server) Seed(collectionPath string, objects ...map[string]interface{}) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.resourceFor(collectionPath)
+	seeded := make([]map[string]interface{}, 0, len(objects))
+	for _, o := range objects {
+		obj := s.newObject(r, o)
+		s.collection(collectionPath).put(r.keyOf(obj), obj)
+		seeded = append(seeded, copyObject(obj))
+	}
+	return seeded
+}
+
+// Get returns the object stored at objectPath, e.g. /v1/services/<id>
+func (s *Server) Get(objectPath string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collection(path.Dir(objectPath)).objects[path.Base(objectPath)]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(obj), true
+}
+
+// Requests returns how many requests the server received for method and path, e.g.
+// Requests("GET", "/v1/users"), regardless of their query
+func (s *Server) Requests(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[method+" "+path]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	s.requests[req.Method+" "+req.URL.Path]++
+	s.mu.Unlock()
+
+	if req.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "missing API key")
+		return
+	}
+	s.mux.ServeHTTP(w, req)
+}
+
+func (s *Server) register(r *resource) {
+	s.resources = append(s.resources, r)
+
+	item := r.path + "/{id}"
+	s.mux.HandleFunc("GET "+r.path, s.list(r))
+	s.mux.HandleFunc("GET "+item, s.get(r))
+	if r.readOnly {
+		return
+	}
+	s.mux.HandleFunc("POST "+r.path, s.create(r))
+	s.mux.HandleFunc(r.updateMethod()+" "+item, s.update(r))
+	s.mux.HandleFunc("DELETE "+item, s.delete(r))
+}
+
+func (s *Server) list(r *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		page, err := intParam(query.Get("page"), 1)
+		if err != nil || page < 1 {
+			writeError(w, http.StatusBadRequest, "page is invalid", "page must be a positive integer")
+			return
+		}
+		perPage, err := intParam(query.Get("per_page"), defaultPerPage)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			writeError(w, http.StatusBadRequest, "per_page is invalid", fmt.Sprintf("per_page must be between 1 and %d", maxPerPage))
+			return
+		}
+
+		s.mu.Lock()
+		c := s.collection(req.URL.Path)
+		var matches []map[string]interface{}
+		for _, key := range c.order {
+			obj := c.objects[key]
+			if matchesFilter(obj, query.Get("name"), "name") && matchesFilter(obj, query.Get("query"), "name", "email") {
+				matches = append(matches, copyObject(obj))
+			}
+		}
+		s.mu.Unlock()
+
+		writeJSON(w, http.StatusOK, paginate(matches, page, perPage))
+	}
+}
+
+func (s *Server) get(r *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		s.mu.Lock()
+		obj, ok := s.collection(path.Dir(req.URL.Path)).objects[req.PathValue("id")]
+		if ok {
+			obj = copyObject(obj)
+		}
+		s.mu.Unlock()
+
+		if !ok {
+			writeNotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) create(r *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, ok := decodeBody(w, req)
+		if !ok {
+			return
+		}
+		for _, attr := range r.required {
+			if v, ok := body[attr]; !ok || v == nil || v == "" {
+				writeValidationError(w, attr+" is missing")
+				return
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		c := s.collection(req.URL.Path)
+		obj := s.newObject(r, body)
+		key := r.keyOf(obj)
+		if _, exists := c.objects[key]; exists {
+			writeValidationError(w, r.key+" has already been taken")
+			return
+		}
+		c.put(key, obj)
+		writeJSON(w, http.StatusCreated, obj)
+	}
+}
+
+func (s *Server) update(r *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, ok := decodeBody(w, req)
+		if !ok {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		c := s.collection(path.Dir(req.URL.Path))
+		key := req.PathValue("id")
+		stored, ok := c.objects[key]
+		if !ok {
+			writeNotFound(w, r)
+			return
+		}
+
+		obj := copyObject(stored)
+		merge(obj, body)
+		if r.prepare != nil {
+			r.prepare(obj)
+		}
+		obj["updated_at"] = timestamp()
+
+		// Slug-keyed objects move when their slug changes
+		newKey := r.keyOf(obj)
+		if _, exists := c.objects[newKey]; exists && newKey != key {
+			writeValidationError(w, r.key+" has already been taken")
+			return
+		}
+		if newKey != key {
+			c.remove(key)
+		}
+		c.put(newKey, obj)
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) delete(r *resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		c := s.collection(path.Dir(req.URL.Path))
+		if _, ok := c.objects[req.PathValue("id")]; !ok {
+			writeNotFound(w, r)
+			return
+		}
+		c.remove(req.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// newObject builds a stored object from a create request body. s.mu must be held.
+func (s *Server) newObject(r *resource, body map[string]interface{}) map[string]interface{} {
+	obj := copyObject(r.defaults)
+	merge(obj, body)
+	if _, ok := obj["id"]; !ok {
+		s.lastID++
+		obj["id"] = fmt.Sprintf("00000000-0000-4000-8000-%012d", s.lastID)
+	}
+	if _, ok := obj["slug"]; !ok && r.slugFrom != "" {
+		obj["slug"] = slugify(fmt.Sprint(obj[r.slugFrom]))
+	}
+	if r.prepare != nil {
+		r.prepare(obj)
+	}
+	now := timestamp()
+	obj["created_at"] = now
+	obj["updated_at"] = now
+	return obj
+}
+
+// resourceFor finds the resource whose path pattern matches collectionPath
+func (s *Server) resourceFor(collectionPath string) *resource {
+	segments := strings.Split(strings.Trim(collectionPath, "/"), "/")
+	for _, r := range s.resources {
+		pattern := strings.Split(strings.Trim(r.path, "/"), "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+		match := true
+		for i := range pattern {
+			if !strings.HasPrefix(pattern[i], "{") && pattern[i] != segments[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return r
+		}
+	}
+	panic(fmt.Sprintf("fakeapi: no collection at %s", collectionPath))
+}
+
+// collection returns the collection stored at collectionPath, creating it if needed.
+// s.mu must be held.
+func (s *Server) collection(collectionPath string) *collection {
+	collectionPath = "/" + strings.Trim(collectionPath, "/")
+	c, ok := s.collections[collectionPath]
+	if !ok {
+		c = &collection{objects: map[string]map[string]interface{}{}}
+		s.collections[collectionPath] = c
+	}
+	return c
+}
+
+func (c *collection) put(key string, obj map[string]interface{}) {
+	if _, exists := c.objects[key]; !exists {
+		c.order = append(c.order, key)
+	}
+	c.objects[key] = obj
+}
+
+func (c *collection) remove(key string) {
+	delete(c.objects, key)
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// merge applies a request body to obj the way the API does: attributes that are sent
+// replace the stored ones and remove_<attribute> flags clear them
+func merge(obj, body map[string]interface{}) {
+	var removals []string
+	for k, v := range body {
+		if attr, ok := strings.CutPrefix(k, "remove_"); ok {
+			if remove, _ := v.(bool); remove {
+				removals = append(removals, attr)
+			}
+			continue
+		}
+		obj[k] = v
+	}
+	for _, attr := range removals {
+		delete(obj, attr)
+	}
+}
+
+// paginate returns a single page of objects wrapped the way every list endpoint wraps them
+func paginate(objects []map[string]interface{}, page, perPage int) map[string]interface{} {
+	count := len(objects)
+	pages := (count + perPage - 1) / perPage
+	if pages == 0 {
+		pages = 1
+	}
+
+	data := []map[string]interface{}{}
+	if start := (page - 1) * perPage; start < count {
+		data = objects[start:min(start+perPage, count)]
+	}
+
+	pagination := map[string]interface{}{
+		"count": count,
+		"page":  page,
+		"items": len(data),
+		"pages": pages,
+		"last":  pages,
+		"prev":  nil,
+		"next":  nil,
+	}
+	if page > 1 {
+		pagination["prev"] = page - 1
+	}
+	if page < pages {
+		pagination["next"] = page + 1
+	}
+
+	return map[string]interface{}{"data": data, "pagination": pagination}
+}
+
+// matchesFilter reports whether any of the attributes contains value, ignoring case. An
+// empty value matches everything.
+func matchesFilter(obj map[string]interface{}, value string, attrs ...string) bool {
+	if value == "" {
+		return true
+	}
+	for _, attr := range attrs {
+		s, _ := obj[attr].(string)
+		if strings.Contains(strings.ToLower(s), strings.ToLower(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(w http.ResponseWriter, req *http.Request) (map[string]interface{}, bool) {
+	body := map[string]interface{}{}
+	if req.ContentLength == 0 {
+		return body, true
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Bad Request", fmt.Sprintf("invalid JSON body: %v", err))
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error":    message,
+		"detail":   detail,
+		"messages": []string{detail},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, r *resource) {
+	writeError(w, http.StatusNotFound, "Record not found", fmt.Sprintf("%s not found", r.name))
+}
+
+func writeValidationError(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusUnprocessableEntity, message, message)
+}
+
+func intParam(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// copyObject returns a deep copy of obj, so stored objects never share maps or slices with
+// request bodies or responses
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return map[string]interface{}{}
+	}
+	b, _ := json.Marshal(obj)
+	c := map[string]interface{}{}
+	json.Unmarshal(b, &c)
+	return c
+}
+
+func slugify(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,254 @@
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+)
+
+func newTestClient(t *testing.T, server *Server) *firehydrant.APIClient {
+	t.Helper()
+
+	client, err := firehydrant.NewRestClient("test-token-very-authorized", firehydrant.WithBaseURL(server.BaseURL()))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	return client
+}
+
+func TestServerCRUD(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.Ping(ctx); err != nil {
+		t.Fatalf("Received error pinging: %s", err.Error())
+	}
+
+	description := "Takes payments"
+	created, err := client.Sdk.CatalogEntries.CreateService(ctx, components.CreateService{Name: "Payments", Description: &description})
+	if err != nil {
+		t.Fatalf("Received error creating service: %s", err.Error())
+	}
+	if created.GetID() == nil || *created.GetID() == "" {
+		t.Fatalf("Expected an ID to be generated, got: %v", created.GetID())
+	}
+	if expected, got := "payments", *created.GetSlug(); expected != got {
+		t.Fatalf("Expected slug %s, got: %s", expected, got)
+	}
+	if expected, got := 5, *created.GetServiceTier(); expected != got {
+		t.Fatalf("Expected default service tier %d, got: %d", expected, got)
+	}
+
+	name := "Payments API"
+	if _, err := client.Sdk.CatalogEntries.UpdateService(ctx, *created.GetID(), components.UpdateService{Name: &name}); err != nil {
+		t.Fatalf("Received error updating service: %s", err.Error())
+	}
+	service, err := client.Sdk.CatalogEntries.GetService(ctx, *created.GetID())
+	if err != nil {
+		t.Fatalf("Received error getting service: %s", err.Error())
+	}
+	if *service.GetName() != name || *service.GetDescription() != description {
+		t.Fatalf("Expected update to keep attributes that weren't sent, got name %q and description %q", *service.GetName(), *service.GetDescription())
+	}
+
+	if err := client.Sdk.CatalogEntries.DeleteService(ctx, *created.GetID()); err != nil {
+		t.Fatalf("Received error deleting service: %s", err.Error())
+	}
+	_, err = client.Sdk.CatalogEntries.GetService(ctx, *created.GetID())
+	if !firehydrant.IsNotFound(err) {
+		t.Fatalf("Expected not found error after delete, got: %v", err)
+	}
+	if _, ok := server.Get("/v1/services/" + *created.GetID()); ok {
+		t.Fatalf("Expected service to be removed from the server")
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	for i := 1; i <= 5; i++ {
+		server.Seed("/v1/environments", map[string]interface{}{"name": fmt.Sprintf("environment-%d", i)})
+	}
+
+	perPage := 2
+	var names []string
+	for page := 1; ; page++ {
+		response, err := client.Sdk.CatalogEntries.ListEnvironments(context.Background(), &page, &perPage, nil, nil)
+		if err != nil {
+			t.Fatalf("Received error listing page %d: %s", page, err.Error())
+		}
+		for _, environment := range response.GetData() {
+			names = append(names, *environment.GetName())
+		}
+
+		pagination := response.GetPagination()
+		if expected, got := 5, *pagination.GetCount(); expected != got {
+			t.Fatalf("Expected count %d, got %d", expected, got)
+		}
+		if pagination.GetNext() == nil {
+			break
+		}
+		if expected, got := page+1, *pagination.GetNext(); expected != got {
+			t.Fatalf("Expected next page %d, got %d", expected, got)
+		}
+	}
+
+	if expected, got := "environment-1,environment-2,environment-3,environment-4,environment-5", strings.Join(names, ","); expected != got {
+		t.Fatalf("Expected environments %s, got %s", expected, got)
+	}
+
+	query := "ENVIRONMENT-3"
+	response, err := client.Sdk.CatalogEntries.ListEnvironments(context.Background(), nil, nil, &query, nil)
+	if err != nil {
+		t.Fatalf("Received error listing environments: %s", err.Error())
+	}
+	if expected, got := 1, len(response.GetData()); expected != got {
+		t.Fatalf("Expected query to match %d environment, got %d", expected, got)
+	}
+}
+
+func TestServerTeamScopedCollections(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	rule, err := client.Sdk.Signals.CreateTeamSignalRule(ctx, "team-1", components.CreateTeamSignalRule{
+		Name:       "Checkout alerts",
+		Expression: "signal.summary.contains('checkout')",
+		TargetType: components.CreateTeamSignalRuleTargetTypeUser,
+		TargetID:   "user-1",
+	})
+	if err != nil {
+		t.Fatalf("Received error creating signal rule: %s", err.Error())
+	}
+	if expected, got := "user-1", *rule.GetTarget().GetID(); expected != got {
+		t.Fatalf("Expected target ID %s, got %s", expected, got)
+	}
+
+	_, err = client.Sdk.Signals.GetTeamSignalRule(ctx, "team-2", *rule.GetID())
+	if !firehydrant.IsNotFound(err) {
+		t.Fatalf("Expected signal rule to only exist under its own team, got: %v", err)
+	}
+
+	rules, err := client.Sdk.Signals.ListTeamSignalRules(ctx, "team-2", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Received error listing signal rules: %s", err.Error())
+	}
+	if len(rules.GetData()) != 0 {
+		t.Fatalf("Expected no signal rules for another team, got %d", len(rules.GetData()))
+	}
+
+	schedule, err := client.Sdk.Signals.CreateTeamOnCallSchedule(ctx, "team-1", components.CreateTeamOnCallSchedule{
+		Name:      "Primary",
+		MemberIds: []string{"user-1", "user-2"},
+	})
+	if err != nil {
+		t.Fatalf("Received error creating on-call schedule: %s", err.Error())
+	}
+	if expected, got := 2, len(schedule.GetMembers()); expected != got {
+		t.Fatalf("Expected %d members, got %d", expected, got)
+	}
+	if expected, got := "Primary", *schedule.GetRotations()[0].GetName(); expected != got {
+		t.Fatalf("Expected rotation to be named after the schedule, got %s", got)
+	}
+}
+
+func TestServerSlugKeyedCollections(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.Severities().Create(ctx, firehydrant.CreateSeverityRequest{Slug: "SEV1"}); err != nil {
+		t.Fatalf("Received error creating severity: %s", err.Error())
+	}
+	if _, err := client.Severities().Update(ctx, "SEV1", firehydrant.UpdateSeverityRequest{Slug: "SEV0"}); err != nil {
+		t.Fatalf("Received error updating severity: %s", err.Error())
+	}
+	if _, err := client.Severities().Get(ctx, "SEV1"); !firehydrant.IsNotFound(err) {
+		t.Fatalf("Expected the old slug to be gone, got: %v", err)
+	}
+	severity, err := client.Severities().Get(ctx, "SEV0")
+	if err != nil {
+		t.Fatalf("Received error getting renamed severity: %s", err.Error())
+	}
+	if expected, got := "SEV0", severity.Slug; expected != got {
+		t.Fatalf("Expected slug %s, got %s", expected, got)
+	}
+
+	_, err = client.Severities().Create(ctx, firehydrant.CreateSeverityRequest{Slug: "SEV0"})
+	var apiErr *firehydrant.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected a 422 for a duplicate slug, got: %v", err)
+	}
+	if expected := "slug has already been taken"; apiErr.APIError.Error != expected {
+		t.Fatalf("Expected error %q, got %q", expected, apiErr.APIError.Error)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		authorization  string
+		body           string
+		expectedStatus int
+		expectedError  string
+	}{
+		{name: "missing API key", method: "GET", path: "/v1/ping", expectedStatus: http.StatusUnauthorized, expectedError: "Unauthorized"},
+		{name: "unknown route", method: "GET", path: "/v1/unknown", authorization: "key", expectedStatus: http.StatusNotFound, expectedError: "Not Found"},
+		{name: "missing object", method: "GET", path: "/v1/teams/missing", authorization: "key", expectedStatus: http.StatusNotFound, expectedError: "Record not found"},
+		{name: "missing attribute", method: "POST", path: "/v1/teams", authorization: "key", body: `{"description":"no name"}`, expectedStatus: http.StatusUnprocessableEntity, expectedError: "name is missing"},
+		{name: "invalid JSON", method: "POST", path: "/v1/teams", authorization: "key", body: `{`, expectedStatus: http.StatusBadRequest, expectedError: "Bad Request"},
+		{name: "invalid page", method: "GET", path: "/v1/teams?per_page=500", authorization: "key", expectedStatus: http.StatusBadRequest, expectedError: "per_page is invalid"},
+		{name: "read only collection", method: "POST", path: "/v1/users", authorization: "key", body: `{}`, expectedStatus: http.StatusNotFound, expectedError: "Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, res.StatusCode)
+			}
+			if tt.expectedError == "" {
+				return
+			}
+			apiError := firehydrant.APIError{}
+			if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
+				t.Fatalf("Expected a JSON error body: %s", err.Error())
+			}
+			if apiError.Error != tt.expectedError {
+				t.Fatalf("Expected error %q, got %q", tt.expectedError, apiError.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package fakeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resource describes a collection endpoint of the API and the objects stored behind it
+type resource struct {
+	// name is used in error messages
+	name string
+	// path is the collection path as a ServeMux pattern, e.g.
+	// /v1/teams/{team_id}/signal_rules
+	path string
+	// key is the attribute objects are addressed by in URLs, "id" unless set
+	key string
+	// slugFrom is the attribute a slug is generated from when none is given
+	slugFrom string
+	required []string
+	// defaults are attributes the API always returns, even when they weren't sent
+	defaults map[string]interface{}
+	// prepare turns request-only attributes of a stored object into the form the API
+	// returns them in, after a create or update request body was merged into it
+	prepare func(obj map[string]interface{})
+	// method used for updates, PATCH unless set
+	method string
+	// readOnly collections can only be listed and read, their objects are added with Seed
+	readOnly bool
+}
+
+func (r *resource) keyOf(obj map[string]interface{}) string {
+	if r.key == "" {
+		return fmt.Sprint(obj["id"])
+	}
+	return fmt.Sprint(obj[r.key])
+}
+
+func (r *resource) updateMethod() string {
+	if r.method == "" {
+		return "PATCH"
+	}
+	return r.method
+}
+
+// resources are the endpoints the fake API serves
+var resources = []*resource{
+	{
+		name:     "service",
+		path:     "/v1/services",
+		slugFrom: "name",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description":              "",
+			"alert_on_add":             false,
+			"auto_add_responding_team": false,
+			"service_tier":             5,
+			"labels":                   map[string]interface{}{},
+			"links":                    []interface{}{},
+			"teams":                    []interface{}{},
+			"external_resources":       []interface{}{},
+		},
+	},
+	{
+		name:     "service dependency",
+		path:     "/v1/service_dependencies",
+		required: []string{"service_id", "connected_service_id"},
+		prepare: func(obj map[string]interface{}) {
+			renameToObject(obj, "service_id", "service")
+			renameToObject(obj, "connected_service_id", "connected_service")
+		},
+	},
+	{
+		name:     "functionality",
+		path:     "/v1/functionalities",
+		slugFrom: "name",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description":              "",
+			"auto_add_responding_team": false,
+			"services":                 []interface{}{},
+			"teams":                    []interface{}{},
+			"links":                    []interface{}{},
+		},
+	},
+	{
+		name:     "environment",
+		path:     "/v1/environments",
+		slugFrom: "name",
+		required: []string{"name"},
+		defaults: map[string]interface{}{"description": ""},
+	},
+	{
+		name:     "team",
+		path:     "/v1/teams",
+		slugFrom: "name",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description":    "",
+			"memberships":    []interface{}{},
+			"owned_services": []interface{}{},
+		},
+		prepare: func(obj map[string]interface{}) {
+			memberships := objects(obj["memberships"])
+			for _, membership := range memberships {
+				renameToObject(membership, "user_id", "user")
+				renameToObject(membership, "schedule_id", "schedule")
+				renameToObject(membership, "incident_role_id", "default_incident_role")
+			}
+			obj["memberships"] = memberships
+		},
+	},
+	{
+		name: "user",
+		path: "/v1/users",
+		defaults: map[string]interface{}{
+			"name":  "",
+			"email": "",
+		},
+		readOnly: true,
+	},
+	{
+		name:     "schedule",
+		path:     "/v1/schedules",
+		readOnly: true,
+	},
+	{
+		name:     "severity",
+		path:     "/v1/severities",
+		key:      "slug",
+		required: []string{"slug"},
+		defaults: map[string]interface{}{
+			"description": "",
+			"type":        "unexpected_downtime",
+		},
+	},
+	{
+		name:     "priority",
+		path:     "/v1/priorities",
+		key:      "slug",
+		required: []string{"slug"},
+		defaults: map[string]interface{}{
+			"description": "",
+			"default":     false,
+		},
+	},
+	{
+		name:     "incident role",
+		path:     "/v1/incident_roles",
+		required: []string{"name", "summary"},
+		defaults: map[string]interface{}{"description": ""},
+	},
+	{
+		name:     "incident type",
+		path:     "/v1/incident_types",
+		required: []string{"name"},
+	},
+	{
+		name:     "role",
+		path:     "/v1/roles",
+		slugFrom: "name",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description": "",
+			"permissions": []interface{}{},
+		},
+	},
+	{
+		name:     "runbook",
+		path:     "/v1/runbooks",
+		method:   "PUT",
+		required: []string{"name", "type"},
+		defaults: map[string]interface{}{
+			"description": "",
+			"steps":       []interface{}{},
+		},
+		prepare: func(obj map[string]interface{}) {
+			steps := objects(obj["steps"])
+			for i, step := range steps {
+				if _, ok := step["step_id"]; !ok {
+					step["step_id"] = fmt.Sprintf("%s-step-%d", obj["id"], i)
+				}
+			}
+			obj["steps"] = steps
+		},
+	},
+	{
+		name:     "task list",
+		path:     "/v1/task_lists",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description":     "",
+			"task_list_items": []interface{}{},
+		},
+	},
+	{
+		name:     "status update template",
+		path:     "/v1/status_update_templates",
+		required: []string{"name", "body"},
+	},
+	{
+		name:     "email target",
+		path:     "/v1/signals/email_targets",
+		slugFrom: "name",
+		required: []string{"name"},
+		prepare: func(obj map[string]interface{}) {
+			if _, ok := obj["email"]; !ok {
+				obj["email"] = fmt.Sprintf("%s@fake-api.firehydrant.io", obj["slug"])
+			}
+		},
+	},
+	{
+		name:     "escalation policy",
+		path:     "/v1/teams/{team_id}/escalation_policies",
+		required: []string{"name", "steps"},
+		defaults: map[string]interface{}{
+			"description":   "",
+			"default":       false,
+			"repetitions":   0,
+			"step_strategy": "static",
+		},
+		prepare: func(obj map[string]interface{}) {
+			if step, ok := obj["handoff_step"].(map[string]interface{}); ok {
+				obj["handoff_step"] = handoffStep(step)
+			}
+
+			settings, ok := obj["prioritized_settings"].(map[string]interface{})
+			if !ok {
+				return
+			}
+			delete(obj, "prioritized_settings")
+			var policies []interface{}
+			for _, priority := range []string{"high", "medium", "low"} {
+				setting, ok := settings[priority].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				policy := map[string]interface{}{
+					"notification_priority": strings.ToUpper(priority),
+					"repetitions":           setting["repetitions"],
+				}
+				if step, ok := setting["handoff_step"].(map[string]interface{}); ok {
+					policy["handoff_step"] = handoffStep(step)
+				}
+				policies = append(policies, policy)
+			}
+			obj["notification_priority_policies"] = policies
+		},
+	},
+	{
+		name:     "on-call schedule",
+		path:     "/v1/teams/{team_id}/on_call_schedules",
+		required: []string{"name"},
+		defaults: map[string]interface{}{
+			"description":  "",
+			"time_zone":    "UTC",
+			"members":      []interface{}{},
+			"restrictions": []interface{}{},
+		},
+		prepare: func(obj map[string]interface{}) {
+			prepareMembers(obj)
+
+			// The API keeps the schedule's settings on its first rotation
+			rotation := map[string]interface{}{"id": fmt.Sprintf("%s-rotation", obj["id"]), "name": obj["name"]}
+			if rotations := objects(obj["rotations"]); len(rotations) > 0 {
+				rotation = rotations[0]
+			}
+			if name, ok := obj["rotation_name"]; ok {
+				rotation["name"] = name
+			}
+			if description, ok := obj["rotation_description"]; ok {
+				rotation["description"] = description
+			}
+			delete(obj, "rotation_name")
+			delete(obj, "rotation_description")
+			obj["rotations"] = []interface{}{rotation}
+		},
+	},
+	{
+		name:     "rotation",
+		path:     "/v1/teams/{team_id}/on_call_schedules/{schedule_id}/rotations",
+		required: []string{"name", "time_zone", "strategy"},
+		defaults: map[string]interface{}{
+			"description":                        "",
+			"enable_slack_channel_notifications": false,
+			"prevent_shift_deletion":             false,
+			"members":                            []interface{}{},
+			"restrictions":                       []interface{}{},
+		},
+		prepare: prepareMembers,
+	},
+	{
+		name:     "signal rule",
+		path:     "/v1/teams/{team_id}/signal_rules",
+		required: []string{"name", "expression", "target_type", "target_id"},
+		prepare: func(obj map[string]interface{}) {
+			target, _ := obj["target"].(map[string]interface{})
+			if target == nil {
+				target = map[string]interface{}{}
+			}
+			if targetType, ok := obj["target_type"]; ok {
+				target["type"] = targetType
+			}
+			if targetID, ok := obj["target_id"]; ok {
+				target["id"] = targetID
+			}
+			delete(obj, "target_type")
+			delete(obj, "target_id")
+			obj["target"] = target
+
+			renameToObject(obj, "incident_type_id", "incident_type")
+		},
+	},
+}
+
+// renameToObject replaces an <attr>_id attribute of a request with the nested object the
+// API returns for it, e.g. {"user_id": "1"} becomes {"user": {"id": "1"}}
+func renameToObject(obj map[string]interface{}, idAttr, objectAttr string) {
+	id, ok := obj[idAttr]
+	if !ok {
+		return
+	}
+	delete(obj, idAttr)
+	if id == nil || id == "" {
+		delete(obj, objectAttr)
+		return
+	}
+	obj[objectAttr] = map[string]interface{}{"id": id}
+}
+
+// prepareMembers turns the member_ids or members[].user_id of a schedule or rotation request
+// into the members the API returns
+func prepareMembers(obj map[string]interface{}) {
+	var members []interface{}
+	if ids, ok := obj["member_ids"].([]interface{}); ok {
+		for _, id := range ids {
+			members = append(members, map[string]interface{}{"id": id})
+		}
+		delete(obj, "member_ids")
+		obj["members"] = members
+		return
+	}
+
+	for _, member := range objects(obj["members"]) {
+		if userID, ok := member["user_id"]; ok {
+			member = map[string]interface{}{"id": userID}
+		}
+		members = append(members, member)
+	}
+	if members != nil {
+		obj["members"] = members
+	}
+}
+
+// handoffStep converts a handoff step request into the entity the API returns
+func handoffStep(step map[string]interface{}) map[string]interface{} {
+	if _, ok := step["target"]; ok {
+		return step
+	}
+	return map[string]interface{}{
+		"target": map[string]interface{}{
+			"type": step["target_type"],
+			"id":   step["target_id"],
+		},
+	}
+}
+
+// objects returns the elements of a JSON array that are objects
+func objects(v interface{}) []map[string]interface{} {
+	items, _ := v.([]interface{})
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			result = append(result, m)
+		}
+	}
+	return result
+}
@@ -27,8 +27,8 @@ type RESTIngestURLClient struct {
 
 var _ IngestURLClient = &RESTIngestURLClient{}
 
-func (c *RESTIngestURLClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTIngestURLClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get retrieves an ingest URL from FireHydrant.  See below for query params.
@@ -49,7 +49,7 @@ func (c *RESTIngestURLClient) Get(ctx context.Context, params IngestURLParams) (
 		return nil, fmt.Errorf("missing team_id for on_call_schedule_id %s", params.OnCallScheduleID)
 	}
 
-	response, err := c.restClient().Get("signals/ingest_url").QueryStruct(params).Receive(ingestURL, apiError)
+	response, err := c.restClient(ctx).Get("signals/ingest_url").QueryStruct(params).Receive(ingestURL, apiError)
 	if err != nil {
 		return nil, fmt.Errorf("could not get ingest url: %w", err)
 	}
@@ -63,8 +63,9 @@ func (c *RESTIngestURLClient) Get(ctx context.Context, params IngestURLParams) (
 		return nil, fmt.Errorf("no ingest URL found with options %#v", params)
 	}
 
+	// The URL itself is a credential, so it's left out of the logs
 	tflog.Info(ctx, "found ingest URL", map[string]interface{}{
-		"url": ingestURL.URL,
+		"params": fmt.Sprintf("%#v", params),
 	})
 
 	return ingestURL, nil
@@ -0,0 +1,17 @@
+package firehydrant
+
+import "maps"
+
+// WithDefaultLabels sets labels that every labelled resource inherits unless it sets the
+// same key itself
+func WithDefaultLabels(labels map[string]string) OptFunc {
+	return func(c *APIClient) error {
+		c.defaultLabels = maps.Clone(labels)
+		return nil
+	}
+}
+
+// DefaultLabels returns the labels set with WithDefaultLabels
+func (c *APIClient) DefaultLabels() map[string]string {
+	return c.defaultLabels
+}
@@ -0,0 +1,207 @@
+package firehydrant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem every API request and response is logged under,
+	// at TRACE level. Its level can be set on its own with TF_LOG_PROVIDER_FIREHYDRANT_API.
+	LogSubsystem = "firehydrant_api"
+
+	logSubsystemLevelEnv = "TF_LOG_PROVIDER_FIREHYDRANT_API"
+
+	// logMaxBodySize is the most of a request or response body that is logged
+	logMaxBodySize = 64 * 1024
+
+	redacted = "[REDACTED]"
+)
+
+// sensitiveKeys are JSON attributes, query parameters and headers whose values are
+// redacted from the logs. A key matches when it contains one of these, ignoring case.
+// Anyone holding an ingest URL can send signals to it, so those are redacted as well.
+var sensitiveKeys = []string{"authorization", "api_key", "apikey", "token", "secret", "password", "ingest_url"}
+
+// loggingTransport logs each attempt of a request with its response through tflog, so
+// it's possible to see what the provider actually sent when the API ignores a field.
+// It sits below the retry transport, which makes every retried attempt its own entry.
+type loggingTransport struct {
+	next http.RoundTripper
+	// token is the API key, masked wherever it shows up in a logged value
+	token string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := newLogSubsystem(req.Context())
+	if t.token != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, t.token)
+	}
+
+	requestBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             redactURL(req.URL),
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    redactBody(requestBody),
+	}
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Sending API request", fields)
+
+	start := time.Now()
+	response, err := t.next.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, LogSubsystem, "API request failed", fields)
+		return response, err
+	}
+
+	responseBody, err := peekResponseBody(response)
+	if err != nil {
+		return nil, err
+	}
+	fields["http_status"] = response.StatusCode
+	fields["http_request_id"] = response.Header.Get("X-Request-Id")
+	fields["http_response_body"] = redactBody(responseBody)
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Received API response", fields)
+
+	return response, nil
+}
+
+// newLogSubsystem adds the API logging subsystem to ctx. Unless its own level is set, the
+// subsystem logs at the provider's level.
+func newLogSubsystem(ctx context.Context) context.Context {
+	if os.Getenv(logSubsystemLevelEnv) != "" {
+		return tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(logSubsystemLevelEnv))
+	}
+	return tflog.NewSubsystem(ctx, LogSubsystem)
+}
+
+// peekRequestBody returns the request body without consuming it
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// peekResponseBody reads the response body and replaces it, so it can still be decoded
+func peekResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || response.Body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redactedHeaders := make(map[string]string, len(header))
+	for key, values := range header {
+		value := strings.Join(values, ", ")
+		if isSensitiveKey(key) {
+			value = redacted
+		}
+		redactedHeaders[key] = value
+	}
+	return redactedHeaders
+}
+
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+
+	for key := range query {
+		if isSensitiveKey(key) {
+			query.Set(key, redacted)
+		}
+	}
+	redactedURL := *u
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+// redactBody redacts sensitive attributes of a JSON body. Bodies that aren't JSON are
+// logged as they are, and long bodies are truncated.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if b, err := json.Marshal(redactJSON(v)); err == nil {
+			body = b
+		}
+	}
+
+	if len(body) > logMaxBodySize {
+		return string(body[:logMaxBodySize]) + "... (truncated)"
+	}
+	return string(body)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
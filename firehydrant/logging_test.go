@@ -0,0 +1,111 @@
+package firehydrant
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Request-Id", "request-1")
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/v1/severities":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"slug":"SEV5","description":"logged"}`))
+		case "/v1/services":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"service-1","name":"Payments","webhook_secret":"hunter2"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if _, err := c.Severities().Create(ctx, CreateSeverityRequest{Slug: "SEV5", Description: "logged"}); err != nil {
+		t.Fatalf("Received error creating severity: %s", err.Error())
+	}
+	if _, err := c.Sdk.CatalogEntries.CreateService(ctx, components.CreateService{Name: "Payments"}); err != nil {
+		t.Fatalf("Received error creating service: %s", err.Error())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Received error decoding logs: %s", err.Error())
+	}
+
+	var responses []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+LogSubsystem {
+			t.Fatalf("Expected entries to be logged under the %s subsystem, got %v", LogSubsystem, entry["@module"])
+		}
+		if entry["@level"] != "trace" {
+			t.Fatalf("Expected entries to be logged at trace level, got %v", entry["@level"])
+		}
+		if entry["@message"] == "Received API response" {
+			responses = append(responses, entry)
+		}
+	}
+	if expected, got := 2, len(responses); expected != got {
+		t.Fatalf("Expected %d responses to be logged, one each for the REST client and the SDK, got %d", expected, got)
+	}
+
+	rest := responses[0]
+	if rest["http_method"] != "POST" || rest["http_url"] != ts.URL+"/v1/severities" {
+		t.Fatalf("Expected the request method and URL to be logged, got %v %v", rest["http_method"], rest["http_url"])
+	}
+	if rest["http_status"] != float64(http.StatusCreated) || rest["http_request_id"] != "request-1" {
+		t.Fatalf("Expected the status and request ID to be logged, got %v %v", rest["http_status"], rest["http_request_id"])
+	}
+	if _, ok := rest["http_duration_ms"]; !ok {
+		t.Fatalf("Expected the latency to be logged")
+	}
+	if body := rest["http_request_body"].(string); !strings.Contains(body, `"description":"logged"`) {
+		t.Fatalf("Expected the request body to be logged, got %s", body)
+	}
+
+	sdk := responses[1]
+	if body := sdk["http_response_body"].(string); !strings.Contains(body, `"name":"Payments"`) || strings.Contains(body, "hunter2") {
+		t.Fatalf("Expected the response body to be logged with secrets redacted, got %s", body)
+	}
+
+	if strings.Contains(output.String(), "test-token-very-authorized") {
+		t.Fatalf("Expected the API key to be redacted from the logs")
+	}
+	headers := sdk["http_request_headers"].(map[string]interface{})
+	if headers["Authorization"] != redacted {
+		t.Fatalf("Expected the Authorization header to be redacted, got %v", headers["Authorization"])
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]string{
+		`{"name":"Payments","api_key":"abc"}`:                       `{"api_key":"[REDACTED]","name":"Payments"}`,
+		`{"data":[{"ingest_url":"https://example.com?token=abc"}]}`: `{"data":[{"ingest_url":"[REDACTED]"}]}`,
+		`{"auth":{"Token":"abc","type":"bearer"}}`:                  `{"auth":{"Token":"[REDACTED]","type":"bearer"}}`,
+		`not json`: `not json`,
+		``:         ``,
+	}
+
+	for input, expected := range tests {
+		if got := redactBody([]byte(input)); got != expected {
+			t.Errorf("redactBody(%s): expected %s, got %s", input, expected, got)
+		}
+	}
+}
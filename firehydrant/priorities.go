@@ -0,0 +1,64 @@
+package firehydrant
+
+import (
+	"context"
+
+	"github.com/dghubble/sling"
+	"github.com/pkg/errors"
+)
+
+// PrioritiesClient is an interface for listing priorities on FireHydrant. Single
+// priorities are managed through the SDK, but its ListPriorities is typed as
+// returning a single priority instead of a page of them.
+type PrioritiesClient interface {
+	List(ctx context.Context, req *PriorityQuery) (*PrioritiesResponse, error)
+}
+
+// RESTPrioritiesClient implements the PrioritiesClient interface
+type RESTPrioritiesClient struct {
+	client *APIClient
+}
+
+var _ PrioritiesClient = &RESTPrioritiesClient{}
+
+func (c *RESTPrioritiesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
+}
+
+// PriorityResponse is the payload for a single priority
+// URL: GET https://api.firehydrant.io/v1/priorities/{slug}
+type PriorityResponse struct {
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+// PriorityQuery is the query used to list priorities
+type PriorityQuery struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// PrioritiesResponse is the payload for retrieving a list of priorities
+// URL: GET https://api.firehydrant.io/v1/priorities
+type PrioritiesResponse struct {
+	Priorities []PriorityResponse `json:"data"`
+	Pagination *Pagination        `json:"pagination,omitempty"`
+}
+
+// List retrieves a page of priorities from FireHydrant
+func (c *RESTPrioritiesClient) List(ctx context.Context, req *PriorityQuery) (*PrioritiesResponse, error) {
+	prioritiesResponse := &PrioritiesResponse{}
+	apiError := &APIError{}
+	response, err := c.restClient(ctx).Get("priorities").QueryStruct(req).Receive(prioritiesResponse, apiError)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list priorities")
+	}
+
+	err = checkResponseStatusCode(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return prioritiesResponse, nil
+}
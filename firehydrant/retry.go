@@ -0,0 +1,158 @@
+package firehydrant
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried before giving up
+	DefaultMaxRetries = 4
+	// DefaultRetryMaxWait is the longest the client will wait between two attempts
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseWait = 500 * time.Millisecond
+)
+
+// retryTransport retries requests that were rate limited or failed with a server error.
+// Rate limited (429) requests are retried regardless of method since the API rejected them
+// before doing any work; server errors and connection failures are only retried for
+// idempotent methods so we never create a resource twice.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		response, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, response, err) {
+			return response, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// The body was already consumed and can't be replayed
+			return response, err
+		}
+
+		wait := t.backoff(attempt, response)
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// Waiting would outlive the request's context, e.g. the resource's timeout, so
+			// return the last result instead of failing with a context error after sleeping
+			return response, err
+		}
+		if response != nil {
+			// Drain the body so the underlying connection can be reused
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, response *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		// An untrusted certificate won't become trusted by trying again
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch code := response.StatusCode; {
+	case code == http.StatusTooManyRequests:
+		return true
+	case code >= 500 && code != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt. Server provided hints win over
+// our own exponential backoff, but both are capped at maxWait.
+func (t *retryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if wait, ok := retryAfter(response.Header, time.Now()); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+
+	// Full jitter: pick a random duration between 0 and the exponential ceiling
+	ceiling := retryBaseWait << attempt
+	if ceiling <= 0 || ceiling > t.maxWait {
+		ceiling = t.maxWait
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// retryAfter parses the rate limit hints the API may send back. Retry-After is either a
+// number of seconds or an HTTP date, RateLimit-Reset is a number of seconds and
+// X-RateLimit-Reset is a unix timestamp.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return max(date.Sub(now), 0), true
+		}
+	}
+
+	if v := header.Get("RateLimit-Reset"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" && header.Get("X-RateLimit-Remaining") == "0" {
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Unix(epoch, 0).Sub(now), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
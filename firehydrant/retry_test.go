@@ -0,0 +1,176 @@
+package firehydrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryRateLimited(t *testing.T) {
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(pingResponseJSON))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL), WithRetryMaxWait(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+	}
+	if expected, got := int32(3), atomic.LoadInt32(&attempts); expected != got {
+		t.Fatalf("Expected %d attempts, got %d", expected, got)
+	}
+}
+
+func TestRetryRateLimitedReplaysBody(t *testing.T) {
+	var attempts int32
+	var lastSlug string
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		sev := &SeverityResponse{}
+		if err := json.NewDecoder(req.Body).Decode(sev); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		lastSlug = sev.Slug
+		w.Write([]byte(`{"slug":"SEV5","description":"","type":"gameday"}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL), WithRetryMaxWait(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	if _, err := c.Severities().Create(context.Background(), CreateSeverityRequest{Slug: "SEV5"}); err != nil {
+		t.Fatalf("Received error creating severity: %s", err.Error())
+	}
+	if expected := "SEV5"; expected != lastSlug {
+		t.Fatalf("Expected replayed body with slug %s, got: %s", expected, lastSlug)
+	}
+}
+
+func TestRetryServerErrorNotIdempotent(t *testing.T) {
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL), WithRetryMaxWait(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	if _, err := c.Severities().Create(context.Background(), CreateSeverityRequest{Slug: "SEV5"}); err == nil {
+		t.Fatalf("Expected error creating severity, got nil")
+	}
+	if expected, got := int32(1), atomic.LoadInt32(&attempts); expected != got {
+		t.Fatalf("Expected %d attempts for a POST, got %d", expected, got)
+	}
+
+	atomic.StoreInt32(&attempts, 0)
+	if _, err := c.Severities().Get(context.Background(), "SEV5"); err == nil {
+		t.Fatalf("Expected error getting severity, got nil")
+	}
+	if expected, got := int32(DefaultMaxRetries+1), atomic.LoadInt32(&attempts); expected != got {
+		t.Fatalf("Expected %d attempts for a GET, got %d", expected, got)
+	}
+}
+
+func TestRetryStopsAtContextDeadline(t *testing.T) {
+	var attempts int32
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err = c.Severities().Get(ctx, "SEV5")
+	if !IsRateLimited(err) {
+		t.Fatalf("Expected the rate limited response to be returned, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("Expected to give up without waiting for Retry-After, took %s", elapsed)
+	}
+	if expected, got := int32(1), atomic.LoadInt32(&attempts); expected != got {
+		t.Fatalf("Expected %d attempt, got %d", expected, got)
+	}
+}
+
+func TestRESTClientHonorsContext(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Severities().Get(ctx, "SEV5"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the request to be cancelled by the context, got: %v", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		header   http.Header
+		expected time.Duration
+		ok       bool
+	}{
+		{"none", http.Header{}, 0, false},
+		{"seconds", http.Header{"Retry-After": {"7"}}, 7 * time.Second, true},
+		{"http date", http.Header{"Retry-After": {now.Add(3 * time.Second).Format(http.TimeFormat)}}, 3 * time.Second, true},
+		{"ratelimit reset", http.Header{"Ratelimit-Reset": {"2"}}, 2 * time.Second, true},
+		{"x-ratelimit reset", http.Header{"X-Ratelimit-Reset": {"1704110405"}, "X-Ratelimit-Remaining": {"0"}}, 5 * time.Second, true},
+		{"x-ratelimit with quota left", http.Header{"X-Ratelimit-Reset": {"1704110405"}, "X-Ratelimit-Remaining": {"3"}}, 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := retryAfter(tc.header, now)
+			if ok != tc.ok || got != tc.expected {
+				t.Fatalf("Expected (%s, %t), got (%s, %t)", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
@@ -21,8 +21,8 @@ type RESTRunbookActionsClient struct {
 
 var _ RunbookActionsClient = &RESTRunbookActionsClient{}
 
-func (c *RESTRunbookActionsClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTRunbookActionsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // RunbookActionsResponse is the payload for retrieving runbook actions
@@ -56,7 +56,7 @@ func (c *RESTRunbookActionsClient) Get(ctx context.Context, runbookType string,
 	runbookActionResponse := &RunbookActionsResponse{}
 	apiError := &APIError{}
 	query := RunbookActionsQuery{Type: runbookType, Items: 100, Lite: true}
-	response, err := c.restClient().Get("runbooks/actions").QueryStruct(query).Receive(runbookActionResponse, apiError)
+	response, err := c.restClient(ctx).Get("runbooks/actions").QueryStruct(query).Receive(runbookActionResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get runbook")
 	}
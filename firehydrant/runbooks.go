@@ -93,9 +93,24 @@ type RunbookResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// RunbookQuery is the query used to search for runbooks
+type RunbookQuery struct {
+	Name    string `url:"name,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+}
+
+// RunbooksResponse is the payload for retrieving a list of runbooks
+// URL: GET https://api.firehydrant.io/v1/runbooks
+type RunbooksResponse struct {
+	Runbooks   []RunbookResponse `json:"data"`
+	Pagination *Pagination       `json:"pagination,omitempty"`
+}
+
 // RunbooksClient is an interface for interacting with runbooks on FireHydrant
 type RunbooksClient interface {
 	Get(ctx context.Context, id string) (*RunbookResponse, error)
+	List(ctx context.Context, req *RunbookQuery) (*RunbooksResponse, error)
 	Create(ctx context.Context, createReq CreateRunbookRequest) (*RunbookResponse, error)
 	Update(ctx context.Context, id string, updateReq UpdateRunbookRequest) (*RunbookResponse, error)
 	Delete(ctx context.Context, id string) error
@@ -108,15 +123,15 @@ type RESTRunbooksClient struct {
 
 var _ RunbooksClient = &RESTRunbooksClient{}
 
-func (c *RESTRunbooksClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTRunbooksClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get returns a runbook from the FireHydrant API
 func (c *RESTRunbooksClient) Get(ctx context.Context, id string) (*RunbookResponse, error) {
 	runbookResponse := &RunbookResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Get("runbooks/"+id).Receive(runbookResponse, apiError)
+	response, err := c.restClient(ctx).Get("runbooks/"+id).Receive(runbookResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get runbook")
 	}
@@ -129,6 +144,23 @@ func (c *RESTRunbooksClient) Get(ctx context.Context, id string) (*RunbookRespon
 	return runbookResponse, nil
 }
 
+// List returns a list of runbooks matching the query from the FireHydrant API
+func (c *RESTRunbooksClient) List(ctx context.Context, req *RunbookQuery) (*RunbooksResponse, error) {
+	runbooksResponse := &RunbooksResponse{}
+	apiError := &APIError{}
+	response, err := c.restClient(ctx).Get("runbooks").QueryStruct(req).Receive(runbooksResponse, apiError)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list runbooks")
+	}
+
+	err = checkResponseStatusCode(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return runbooksResponse, nil
+}
+
 // Create creates a brand spankin new runbook in FireHydrant
 func (c *RESTRunbooksClient) Create(ctx context.Context, createReq CreateRunbookRequest) (*RunbookResponse, error) {
 	// Set the default type of the runbook
@@ -136,7 +168,7 @@ func (c *RESTRunbooksClient) Create(ctx context.Context, createReq CreateRunbook
 
 	runbookResponse := &RunbookResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Post("runbooks").BodyJSON(&createReq).Receive(runbookResponse, apiError)
+	response, err := c.restClient(ctx).Post("runbooks").BodyJSON(&createReq).Receive(runbookResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create runbook")
 	}
@@ -153,7 +185,7 @@ func (c *RESTRunbooksClient) Create(ctx context.Context, createReq CreateRunbook
 func (c *RESTRunbooksClient) Update(ctx context.Context, id string, updateReq UpdateRunbookRequest) (*RunbookResponse, error) {
 	runbookResponse := &RunbookResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Put("runbooks/"+id).BodyJSON(updateReq).Receive(runbookResponse, apiError)
+	response, err := c.restClient(ctx).Put("runbooks/"+id).BodyJSON(updateReq).Receive(runbookResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not update runbook")
 	}
@@ -168,7 +200,7 @@ func (c *RESTRunbooksClient) Update(ctx context.Context, id string, updateReq Up
 
 func (c *RESTRunbooksClient) Delete(ctx context.Context, id string) error {
 	apiError := &APIError{}
-	response, err := c.restClient().Delete("runbooks/"+id).Receive(nil, apiError)
+	response, err := c.restClient(ctx).Delete("runbooks/"+id).Receive(nil, apiError)
 	if err != nil {
 		return errors.Wrap(err, "could not delete runbook")
 	}
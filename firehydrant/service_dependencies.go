@@ -23,8 +23,8 @@ type RESTServiceDependenciesClient struct {
 
 var _ ServiceDependenciesClient = &RESTServiceDependenciesClient{}
 
-func (c *RESTServiceDependenciesClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTServiceDependenciesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // ServiceDependencyResponse is the payload for retrieving a service dependency
@@ -49,7 +49,7 @@ type ServiceDependencyService struct {
 func (c *RESTServiceDependenciesClient) Get(ctx context.Context, id string) (*ServiceDependencyResponse, error) {
 	serviceDependencyResponse := &ServiceDependencyResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Get("service_dependencies/"+id).Receive(serviceDependencyResponse, apiError)
+	response, err := c.restClient(ctx).Get("service_dependencies/"+id).Receive(serviceDependencyResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get service dependency")
 	}
@@ -74,7 +74,7 @@ type CreateServiceDependencyRequest struct {
 func (c *RESTServiceDependenciesClient) Create(ctx context.Context, createReq CreateServiceDependencyRequest) (*ServiceDependencyResponse, error) {
 	serviceDependencyResponse := &ServiceDependencyResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Post("service_dependencies").BodyJSON(&createReq).Receive(serviceDependencyResponse, apiError)
+	response, err := c.restClient(ctx).Post("service_dependencies").BodyJSON(&createReq).Receive(serviceDependencyResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create service dependency")
 	}
@@ -97,7 +97,7 @@ type UpdateServiceDependencyRequest struct {
 func (c *RESTServiceDependenciesClient) Update(ctx context.Context, id string, updateReq UpdateServiceDependencyRequest) (*ServiceDependencyResponse, error) {
 	serviceDependencyResponse := &ServiceDependencyResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Patch("service_dependencies/"+id).BodyJSON(updateReq).Receive(serviceDependencyResponse, apiError)
+	response, err := c.restClient(ctx).Patch("service_dependencies/"+id).BodyJSON(updateReq).Receive(serviceDependencyResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not update service dependency")
 	}
@@ -112,7 +112,7 @@ func (c *RESTServiceDependenciesClient) Update(ctx context.Context, id string, u
 
 func (c *RESTServiceDependenciesClient) Delete(ctx context.Context, id string) error {
 	apiError := &APIError{}
-	response, err := c.restClient().Delete("service_dependencies/"+id).Receive(nil, apiError)
+	response, err := c.restClient(ctx).Delete("service_dependencies/"+id).Receive(nil, apiError)
 	if err != nil {
 		return errors.Wrap(err, "could not delete service dependency")
 	}
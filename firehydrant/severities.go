@@ -31,8 +31,8 @@ type RESTSeveritiesClient struct {
 
 var _ SeveritiesClient = &RESTSeveritiesClient{}
 
-func (c *RESTSeveritiesClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTSeveritiesClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // SeverityResponse is the payload for a single environment
@@ -47,7 +47,7 @@ type SeverityResponse struct {
 func (c *RESTSeveritiesClient) Get(ctx context.Context, slug string) (*SeverityResponse, error) {
 	sevResponse := &SeverityResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Get("severities/"+slug).Receive(sevResponse, apiError)
+	response, err := c.restClient(ctx).Get("severities/"+slug).Receive(sevResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get severity")
 	}
@@ -72,7 +72,7 @@ type CreateSeverityRequest struct {
 func (c *RESTSeveritiesClient) Create(ctx context.Context, createReq CreateSeverityRequest) (*SeverityResponse, error) {
 	sevResponse := &SeverityResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Post("severities").BodyJSON(&createReq).Receive(sevResponse, apiError)
+	response, err := c.restClient(ctx).Post("severities").BodyJSON(&createReq).Receive(sevResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create severity")
 	}
@@ -97,7 +97,7 @@ type UpdateSeverityRequest struct {
 func (c *RESTSeveritiesClient) Update(ctx context.Context, slug string, updateReq UpdateSeverityRequest) (*SeverityResponse, error) {
 	sevResponse := &SeverityResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Patch("severities/"+slug).BodyJSON(&updateReq).Receive(sevResponse, apiError)
+	response, err := c.restClient(ctx).Patch("severities/"+slug).BodyJSON(&updateReq).Receive(sevResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not update severity")
 	}
@@ -113,7 +113,7 @@ func (c *RESTSeveritiesClient) Update(ctx context.Context, slug string, updateRe
 // Delete deletes a severity from FireHydrant
 func (c *RESTSeveritiesClient) Delete(ctx context.Context, slug string) error {
 	apiError := &APIError{}
-	response, err := c.restClient().Delete("severities/"+slug).Receive(nil, apiError)
+	response, err := c.restClient(ctx).Delete("severities/"+slug).Receive(nil, apiError)
 	if err != nil {
 		return errors.Wrap(err, "could not delete severity")
 	}
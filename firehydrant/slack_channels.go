@@ -3,6 +3,8 @@ package firehydrant
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/dghubble/sling"
@@ -12,11 +14,13 @@ import (
 type SlackChannelParams struct {
 	ID   string
 	Name string
+	Page int
 }
 
 // SlackChannelsClient is an interface for interacting with Slack channels
 type SlackChannelsClient interface {
 	Get(ctx context.Context, params SlackChannelParams) (*SlackChannelResponse, error)
+	List(ctx context.Context, params SlackChannelParams) (*SlackChannelsResponse, error)
 }
 
 // RESTSlackChannelsClient implements the SlackChannelClient interface
@@ -26,43 +30,39 @@ type RESTSlackChannelsClient struct {
 
 var _ SlackChannelsClient = &RESTSlackChannelsClient{}
 
-func (c *RESTSlackChannelsClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTSlackChannelsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get retrieves a Slack channel from FireHydrant using Slack ID. This is useful for looking up
-// a Slack channel's internal ID.
+// a Slack channel's internal ID. Every page of matches is searched, so a channel matching more
+// than one is an error even when the second match is on a later page.
 func (c *RESTSlackChannelsClient) Get(ctx context.Context, params SlackChannelParams) (*SlackChannelResponse, error) {
-	channels := &SlackChannelsResponse{}
-	apiError := &APIError{}
-
-	query := ""
-	if params.ID != "" {
-		query = fmt.Sprintf("slack_channel_id=%s", params.ID)
-	} else if params.Name != "" {
-		query = fmt.Sprintf("name=%s", strings.TrimPrefix(params.Name, "#"))
-	}
-	response, err := c.restClient().Get("integrations/slack/channels?"+query).Receive(channels, apiError)
-	if err != nil {
-		return nil, fmt.Errorf("could not get slack channel: %w", err)
-	}
+	var channels []*SlackChannelResponse
+	for page := 1; page > 0; {
+		params.Page = page
+		response, err := c.List(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, response.Channels...)
 
-	err = checkResponseStatusCode(response, apiError)
-	if err != nil {
-		return nil, err
+		page = 0
+		if response.Pagination != nil {
+			page = response.Pagination.Next
+		}
 	}
 
-	if len(channels.Channels) == 0 { //len of nil slices is defined as 0
+	if len(channels) == 0 { //len of nil slices is defined as 0
 		return nil, fmt.Errorf("no slack channel found with options name '%s' and / or id '%s'", params.Name, params.ID)
 	}
-	if channelCount := len(channels.Channels); channelCount > 1 {
-		// "at least" because it may paginate.
+	if channelCount := len(channels); channelCount > 1 {
 		tflog.Error(ctx, "found more than one Slack channel", map[string]interface{}{
 			"id":    params.ID,
 			"name":  params.Name,
 			"found": channelCount,
 		})
-		for _, channel := range channels.Channels {
+		for _, channel := range channels {
 			tflog.Error(ctx, "found Slack channel", map[string]interface{}{
 				"id":                 params.ID,
 				"name":               params.Name,
@@ -74,11 +74,39 @@ func (c *RESTSlackChannelsClient) Get(ctx context.Context, params SlackChannelPa
 	}
 
 	tflog.Info(ctx, "found Slack channel", map[string]interface{}{
-		"id":                 channels.Channels[0].ID,
-		"name":               channels.Channels[0].Name,
-		"slack_channel_id":   channels.Channels[0].SlackChannelID,
-		"slack_channel_name": channels.Channels[0].Name,
+		"id":                 channels[0].ID,
+		"name":               channels[0].Name,
+		"slack_channel_id":   channels[0].SlackChannelID,
+		"slack_channel_name": channels[0].Name,
 	})
 
-	return channels.Channels[0], nil
+	return channels[0], nil
+}
+
+// List retrieves a page of the Slack channels matching the Slack ID or name. This is useful
+// for looking up a Slack channel's internal ID.
+func (c *RESTSlackChannelsClient) List(ctx context.Context, params SlackChannelParams) (*SlackChannelsResponse, error) {
+	channels := &SlackChannelsResponse{}
+	apiError := &APIError{}
+
+	query := url.Values{}
+	if params.ID != "" {
+		query.Set("slack_channel_id", params.ID)
+	} else if params.Name != "" {
+		query.Set("name", strings.TrimPrefix(params.Name, "#"))
+	}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	response, err := c.restClient(ctx).Get("integrations/slack/channels?"+query.Encode()).Receive(channels, apiError)
+	if err != nil {
+		return nil, fmt.Errorf("could not get slack channel: %w", err)
+	}
+
+	err = checkResponseStatusCode(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
 }
@@ -138,3 +138,58 @@ func TestSlackChannelGetNotFound_noParams(t *testing.T) {
 		t.Fatalf("expected ErrorNotFound in retrieving slack channel, got nil")
 	}
 }
+
+func TestSlackChannelList_Page(t *testing.T) {
+	var requestPage string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestPage = req.URL.Query().Get("page")
+		w.Write([]byte(expectedSlackChannelsResponseJSON()))
+	}))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+	params := SlackChannelParams{Name: "team-rocket", Page: 2}
+	if _, err := c.SlackChannels().List(context.Background(), params); err != nil {
+		t.Fatalf("error retrieving slack channels: %s", err.Error())
+	}
+	if expected := "2"; expected != requestPage {
+		t.Fatalf("request page mismatch: expected '%s', got: '%s'", expected, requestPage)
+	}
+}
+
+func TestSlackChannelGet_multiplePages(t *testing.T) {
+	var requestPages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestPages = append(requestPages, req.URL.Query().Get("page"))
+		if req.URL.Query().Get("page") != "2" {
+			w.Write([]byte(`{
+	"data": [{"id":"00000000-0000-4000-8000-000000000000","name":"#team-rocket","slack_channel_id":"C01010101Z"}],
+	"pagination": {"count":2,"page":1,"items":1,"pages":2,"last":2,"prev":null,"next":2}
+}`))
+			return
+		}
+		w.Write([]byte(`{
+	"data": [{"id":"00000000-0000-4000-8000-000000000001","name":"#team-rocket","slack_channel_id":"C02020202Z"}],
+	"pagination": {"count":2,"page":2,"items":1,"pages":2,"last":2,"prev":1,"next":null}
+}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+	// The second match is only on the second page
+	params := SlackChannelParams{Name: "team-rocket"}
+	if _, err := c.SlackChannels().Get(context.Background(), params); err == nil {
+		t.Fatalf("expected an error for a name matching more than one slack channel, got nil")
+	}
+	if expected := []string{"1", "2"}; !reflect.DeepEqual(expected, requestPages) {
+		t.Fatalf("request pages mismatch: expected '%v', got: '%v'", expected, requestPages)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 type StatusUpdateTemplates interface {
 	Get(ctx context.Context, id string) (*StatusUpdateTemplateResponse, error)
+	List(ctx context.Context, req *StatusUpdateTemplateQuery) (*StatusUpdateTemplatesResponse, error)
 	Create(ctx context.Context, createReq CreateStatusUpdateTemplateRequest) (*StatusUpdateTemplateResponse, error)
 	Update(ctx context.Context, id string, updateReq UpdateStatusUpdateTemplateRequest) (*StatusUpdateTemplateResponse, error)
 	Delete(ctx context.Context, id string) error
@@ -31,6 +32,19 @@ type StatusUpdateTemplateResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// StatusUpdateTemplateQuery is the query used to list status update templates
+type StatusUpdateTemplateQuery struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// StatusUpdateTemplatesResponse is the payload for retrieving a list of status update templates
+// URL: GET https://api.firehydrant.io/v1/status_update_templates
+type StatusUpdateTemplatesResponse struct {
+	StatusUpdateTemplates []StatusUpdateTemplateResponse `json:"data"`
+	Pagination            *Pagination                    `json:"pagination,omitempty"`
+}
+
 type CreateStatusUpdateTemplateRequest struct {
 	Name string `json:"name"`
 	Body string `json:"body"`
@@ -41,14 +55,14 @@ type UpdateStatusUpdateTemplateRequest struct {
 	Body string `json:"body"`
 }
 
-func (c *RESTStatusUpdateTemplateClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTStatusUpdateTemplateClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 func (c *RESTStatusUpdateTemplateClient) Create(ctx context.Context, createReq CreateStatusUpdateTemplateRequest) (*StatusUpdateTemplateResponse, error) {
 	statusUpdateTemplateResponse := &StatusUpdateTemplateResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Post("status_update_templates").BodyJSON(&createReq).Receive(statusUpdateTemplateResponse, apiError)
+	response, err := c.restClient(ctx).Post("status_update_templates").BodyJSON(&createReq).Receive(statusUpdateTemplateResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create status update template")
 	}
@@ -64,7 +78,7 @@ func (c *RESTStatusUpdateTemplateClient) Create(ctx context.Context, createReq C
 func (c *RESTStatusUpdateTemplateClient) Get(ctx context.Context, id string) (*StatusUpdateTemplateResponse, error) {
 	statusUpdateTemplateResponse := &StatusUpdateTemplateResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Get(fmt.Sprintf("status_update_templates/%s", id)).Receive(statusUpdateTemplateResponse, apiError)
+	response, err := c.restClient(ctx).Get(fmt.Sprintf("status_update_templates/%s", id)).Receive(statusUpdateTemplateResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get status update template")
 	}
@@ -77,10 +91,26 @@ func (c *RESTStatusUpdateTemplateClient) Get(ctx context.Context, id string) (*S
 	return statusUpdateTemplateResponse, nil
 }
 
+func (c *RESTStatusUpdateTemplateClient) List(ctx context.Context, req *StatusUpdateTemplateQuery) (*StatusUpdateTemplatesResponse, error) {
+	statusUpdateTemplatesResponse := &StatusUpdateTemplatesResponse{}
+	apiError := &APIError{}
+	response, err := c.restClient(ctx).Get("status_update_templates").QueryStruct(req).Receive(statusUpdateTemplatesResponse, apiError)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list status update templates")
+	}
+
+	err = checkResponseStatusCode(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return statusUpdateTemplatesResponse, nil
+}
+
 func (c *RESTStatusUpdateTemplateClient) Update(ctx context.Context, id string, updateReq UpdateStatusUpdateTemplateRequest) (*StatusUpdateTemplateResponse, error) {
 	statusUpdateTemplateResponse := &StatusUpdateTemplateResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Patch(fmt.Sprintf("status_update_templates/%s", id)).BodyJSON(&updateReq).Receive(statusUpdateTemplateResponse, apiError)
+	response, err := c.restClient(ctx).Patch(fmt.Sprintf("status_update_templates/%s", id)).BodyJSON(&updateReq).Receive(statusUpdateTemplateResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not update status update template")
 	}
@@ -95,7 +125,7 @@ func (c *RESTStatusUpdateTemplateClient) Update(ctx context.Context, id string,
 
 func (c *RESTStatusUpdateTemplateClient) Delete(ctx context.Context, id string) error {
 	apiError := &APIError{}
-	response, err := c.restClient().Delete(fmt.Sprintf("status_update_templates/%s", id)).Receive(nil, apiError)
+	response, err := c.restClient(ctx).Delete(fmt.Sprintf("status_update_templates/%s", id)).Receive(nil, apiError)
 	if err != nil {
 		return errors.Wrap(err, "could not delete status update template")
 	}
@@ -11,6 +11,7 @@ import (
 // TaskListsClient is an interface for interacting with task lists on FireHydrant
 type TaskListsClient interface {
 	Get(ctx context.Context, id string) (*TaskListResponse, error)
+	List(ctx context.Context, req *TaskListQuery) (*TaskListsResponse, error)
 	Create(ctx context.Context, createReq CreateTaskListRequest) (*TaskListResponse, error)
 	Update(ctx context.Context, id string, updateReq UpdateTaskListRequest) (*TaskListResponse, error)
 	Delete(ctx context.Context, id string) error
@@ -23,8 +24,8 @@ type RESTTaskListsClient struct {
 
 var _ TaskListsClient = &RESTTaskListsClient{}
 
-func (c *RESTTaskListsClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTTaskListsClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // TaskListResponse is the payload for retrieving a task list
@@ -50,7 +51,7 @@ type TaskListItem struct {
 func (c *RESTTaskListsClient) Get(ctx context.Context, id string) (*TaskListResponse, error) {
 	taskListResponse := &TaskListResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Get("task_lists/"+id).Receive(taskListResponse, apiError)
+	response, err := c.restClient(ctx).Get("task_lists/"+id).Receive(taskListResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get task list")
 	}
@@ -63,6 +64,37 @@ func (c *RESTTaskListsClient) Get(ctx context.Context, id string) (*TaskListResp
 	return taskListResponse, nil
 }
 
+// TaskListQuery is the query used to search for task lists
+type TaskListQuery struct {
+	Query   string `url:"query,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+}
+
+// TaskListsResponse is the payload for retrieving a list of task lists
+// URL: GET https://api.firehydrant.io/v1/task_lists
+type TaskListsResponse struct {
+	TaskLists  []TaskListResponse `json:"data"`
+	Pagination *Pagination        `json:"pagination,omitempty"`
+}
+
+// List returns a list of task lists matching the query from the FireHydrant API
+func (c *RESTTaskListsClient) List(ctx context.Context, req *TaskListQuery) (*TaskListsResponse, error) {
+	taskListsResponse := &TaskListsResponse{}
+	apiError := &APIError{}
+	response, err := c.restClient(ctx).Get("task_lists").QueryStruct(req).Receive(taskListsResponse, apiError)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list task lists")
+	}
+
+	err = checkResponseStatusCode(response, apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return taskListsResponse, nil
+}
+
 // CreateTaskListRequest is the payload for creating a task list
 // URL: POST https://api.firehydrant.io/v1/task_lists
 type CreateTaskListRequest struct {
@@ -76,7 +108,7 @@ type CreateTaskListRequest struct {
 func (c *RESTTaskListsClient) Create(ctx context.Context, createReq CreateTaskListRequest) (*TaskListResponse, error) {
 	taskListResponse := &TaskListResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Post("task_lists").BodyJSON(&createReq).Receive(taskListResponse, apiError)
+	response, err := c.restClient(ctx).Post("task_lists").BodyJSON(&createReq).Receive(taskListResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create task list")
 	}
@@ -102,7 +134,7 @@ type UpdateTaskListRequest struct {
 func (c *RESTTaskListsClient) Update(ctx context.Context, id string, updateReq UpdateTaskListRequest) (*TaskListResponse, error) {
 	taskListResponse := &TaskListResponse{}
 	apiError := &APIError{}
-	response, err := c.restClient().Patch("task_lists/"+id).BodyJSON(updateReq).Receive(taskListResponse, apiError)
+	response, err := c.restClient(ctx).Patch("task_lists/"+id).BodyJSON(updateReq).Receive(taskListResponse, apiError)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not update task list")
 	}
@@ -117,7 +149,7 @@ func (c *RESTTaskListsClient) Update(ctx context.Context, id string, updateReq U
 
 func (c *RESTTaskListsClient) Delete(ctx context.Context, id string) error {
 	apiError := &APIError{}
-	response, err := c.restClient().Delete("task_lists/"+id).Receive(nil, apiError)
+	response, err := c.restClient(ctx).Delete("task_lists/"+id).Receive(nil, apiError)
 	if err != nil {
 		return errors.Wrap(err, "could not delete task list")
 	}
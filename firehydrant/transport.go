@@ -0,0 +1,104 @@
+package firehydrant
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// transportConfig holds the network settings of the single transport that the sling and
+// SDK clients share
+type transportConfig struct {
+	proxyURL           *url.URL
+	caCertPEMs         [][]byte
+	clientCertificates []tls.Certificate
+	insecureSkipVerify bool
+}
+
+// WithProxyURL sends every request through the given HTTP, HTTPS or SOCKS5 proxy instead
+// of the proxy configured by the HTTPS_PROXY and NO_PROXY environment variables
+func WithProxyURL(proxyURL string) OptFunc {
+	return func(c *APIClient) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", proxyURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: missing host", proxyURL)
+		}
+		c.transport.proxyURL = u
+		return nil
+	}
+}
+
+// WithCACertPEM trusts the PEM encoded certificate authorities in addition to the system's,
+// e.g. for a proxy that re-signs TLS connections
+func WithCACertPEM(pem []byte) OptFunc {
+	return func(c *APIClient) error {
+		if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid PEM encoded certificates found in CA certificate")
+		}
+		c.transport.caCertPEMs = append(c.transport.caCertPEMs, pem)
+		return nil
+	}
+}
+
+// WithClientCertificate presents the PEM encoded certificate and key when the server or
+// proxy asks for a client certificate (mutual TLS)
+func WithClientCertificate(certPEM, keyPEM []byte) OptFunc {
+	return func(c *APIClient) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+		c.transport.clientCertificates = append(c.transport.clientCertificates, cert)
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the server's TLS certificate. It's
+// meant for testing only, prefer WithCACertPEM for servers with a private CA.
+func WithInsecureSkipVerify(skip bool) OptFunc {
+	return func(c *APIClient) error {
+		c.transport.insecureSkipVerify = skip
+		return nil
+	}
+}
+
+// newTransport returns the transport every request is finally sent with. Unless configured
+// otherwise it behaves like http.DefaultTransport.
+func (cfg transportConfig) newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(cfg.proxyURL)
+	}
+
+	if len(cfg.caCertPEMs) == 0 && len(cfg.clientCertificates) == 0 && !cfg.insecureSkipVerify {
+		return transport
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		Certificates:       cfg.clientCertificates,
+		InsecureSkipVerify: cfg.insecureSkipVerify,
+	}
+	if len(cfg.caCertPEMs) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for _, pem := range cfg.caCertPEMs {
+			pool.AppendCertsFromPEM(pem)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport
+}
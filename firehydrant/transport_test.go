@@ -0,0 +1,167 @@
+package firehydrant
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func pingHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(pingResponseJSON))
+}
+
+func certificatePEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// selfSignedCertificate returns a PEM encoded certificate and key for a client
+func selfSignedCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestClientCACertPEM(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(pingHandler))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	if _, err := c.Ping(context.Background()); err == nil {
+		t.Fatalf("Expected the server's certificate to be rejected without its CA")
+	}
+
+	c, err = NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithCACertPEM(certificatePEM(ts.Certificate())))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+	}
+	if _, err := c.Sdk.AccountSettings.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint through the SDK: %s", err.Error())
+	}
+}
+
+func TestClientInsecureSkipVerify(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(pingHandler))
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL(ts.URL+"/v1/"), WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	certPEM, keyPEM := selfSignedCertificate(t)
+
+	var presented []string
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for _, cert := range req.TLS.PeerCertificates {
+			presented = append(presented, cert.Subject.CommonName)
+		}
+		pingHandler(w, req)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	c, err := NewRestClient("test-token-very-authorized",
+		WithBaseURL(ts.URL+"/v1/"),
+		WithCACertPEM(certificatePEM(ts.Certificate())),
+		WithClientCertificate(certPEM, keyPEM),
+	)
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+	}
+	if len(presented) != 1 || presented[0] != "terraform" {
+		t.Fatalf("Expected the client certificate to be presented, got %v", presented)
+	}
+}
+
+func TestClientProxyURL(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		proxied = append(proxied, req.URL.String())
+		pingHandler(w, req)
+	}))
+	defer proxy.Close()
+
+	c, err := NewRestClient("test-token-very-authorized", WithBaseURL("http://api.firehydrant.test/v1/"), WithProxyURL(proxy.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+	if _, err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint: %s", err.Error())
+	}
+	if _, err := c.Sdk.AccountSettings.Ping(context.Background()); err != nil {
+		t.Fatalf("Received error hitting ping endpoint through the SDK: %s", err.Error())
+	}
+
+	if expected, got := 2, len(proxied); expected != got {
+		t.Fatalf("Expected %d requests through the proxy, got %d", expected, got)
+	}
+	if expected, got := "http://api.firehydrant.test/v1/ping", proxied[0]; expected != got {
+		t.Fatalf("Expected the proxy to receive %s, got %s", expected, got)
+	}
+}
+
+func TestClientTransportOptionErrors(t *testing.T) {
+	certPEM, _ := selfSignedCertificate(t)
+	_, otherKeyPEM := selfSignedCertificate(t)
+
+	tests := map[string]OptFunc{
+		"proxy without scheme":      WithProxyURL("proxy.example.com:3128"),
+		"proxy with ftp scheme":     WithProxyURL("ftp://proxy.example.com"),
+		"CA without certificates":   WithCACertPEM([]byte("not a certificate")),
+		"client cert without key":   WithClientCertificate(certPEM, nil),
+		"client cert and wrong key": WithClientCertificate(certPEM, otherKeyPEM),
+	}
+
+	for name, opt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewRestClient("test-token-very-authorized", opt); err == nil {
+				t.Fatalf("Expected an error initializing the API client")
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ type TransposersParams struct {
 	OnCallScheduleID   string `url:"on_call_schedule_id,omitempty"`
 	TeamID             string `url:"team_id,omitempty"`
 	UserID             string `url:"user_id,omitempty"`
+	Page               int    `url:"page,omitempty"`
 }
 
 // IngestURLClient is an interface for interacting with ingest URLs
@@ -26,8 +27,8 @@ type RESTTransposersClient struct {
 
 var _ TransposersClient = &RESTTransposersClient{}
 
-func (c *RESTTransposersClient) restClient() *sling.Sling {
-	return c.client.client()
+func (c *RESTTransposersClient) restClient(ctx context.Context) *sling.Sling {
+	return c.client.client(ctx)
 }
 
 // Get retrieves an ingest URL from FireHydrant.  See below for query params.
@@ -49,7 +50,7 @@ func (c *RESTTransposersClient) Get(ctx context.Context, params TransposersParam
 		return nil, fmt.Errorf("missing team_id for on_call_schedule_id %s", params.OnCallScheduleID)
 	}
 
-	response, err := c.restClient().Get("signals/transposers").QueryStruct(params).Receive(transposers, apiError)
+	response, err := c.restClient(ctx).Get("signals/transposers").QueryStruct(params).Receive(transposers, apiError)
 	if err != nil {
 		return nil, fmt.Errorf("could not get transposers: %w", err)
 	}
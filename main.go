@@ -1,16 +1,38 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	"github.com/firehydrant/terraform-provider-firehydrant/provider"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/export"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return provider.Provider()
-		},
+	ctx := context.Background()
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Command(ctx, os.Args[2:], os.Stderr); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	server, err := provider.NewServer(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	err = tf5server.Serve("registry.terraform.io/firehydrant/firehydrant", func() tfprotov5.ProviderServer {
+		return server
 	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
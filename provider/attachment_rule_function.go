@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// attachmentRuleArguments is how many values an operator of a runbook's attachment_rule or a
+// runbook step's rule compares its variable with
+type attachmentRuleArguments int
+
+const (
+	attachmentRuleNoArgument attachmentRuleArguments = iota
+	attachmentRuleOneArgument
+	attachmentRuleCollection
+)
+
+var attachmentRuleOperators = map[string]attachmentRuleArguments{
+	"manually":               attachmentRuleNoArgument,
+	"exists":                 attachmentRuleNoArgument,
+	"does_not_exist":         attachmentRuleNoArgument,
+	"is_empty":               attachmentRuleNoArgument,
+	"eq":                     attachmentRuleOneArgument,
+	">":                      attachmentRuleOneArgument,
+	"<=":                     attachmentRuleOneArgument,
+	"runbook_step_completed": attachmentRuleOneArgument,
+	"runbook_step_errored":   attachmentRuleOneArgument,
+	"runbook_step_started":   attachmentRuleOneArgument,
+	"is_one_of":              attachmentRuleCollection,
+	"includes_any":           attachmentRuleCollection,
+	"includes_all":           attachmentRuleCollection,
+	"includes_none_of":       attachmentRuleCollection,
+}
+
+var attachmentRuleValueAttributeTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"value": types.StringType,
+	"label": types.StringType,
+}
+
+// attachmentRuleValue is a value a condition compares its variable with, written to the
+// rule's user_data
+type attachmentRuleValue struct {
+	Type  string `tfsdk:"type" json:"type"`
+	Value string `tfsdk:"value" json:"value"`
+	Label string `tfsdk:"label" json:"label"`
+}
+
+// attachmentRuleFunction builds the JSON logic of a runbook's attachment_rule or a runbook
+// step's rule with a single condition
+type attachmentRuleFunction struct{}
+
+var _ function.Function = attachmentRuleFunction{}
+
+func newAttachmentRuleFunction() function.Function {
+	return attachmentRuleFunction{}
+}
+
+func (f attachmentRuleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "attachment_rule"
+}
+
+func (f attachmentRuleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	operators := make([]string, 0, len(attachmentRuleOperators))
+	for operator := range attachmentRuleOperators {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	resp.Definition = function.Definition{
+		Summary: "Builds a runbook attachment rule or step rule",
+		Description: "Returns the JSON encoded rule with a single condition that applies the operator to the attribute, " +
+			"for a runbook's attachment_rule or a runbook step's rule. The values the attribute is compared with are " +
+			"written to the rule's user_data. The operator is one of " + strings.Join(operators, ", ") + ". " +
+			"`attachment_rule(\"manually\", \"when_invoked\")` returns the default attachment rule.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "operator", Description: "Operator of the condition, e.g. eq or includes_any"},
+			function.StringParameter{Name: "attribute", Description: "Attribute the condition applies to, e.g. incident_current_milestone"},
+		},
+		VariadicParameter: function.ObjectParameter{
+			Name:           "values",
+			Description:    "Values the attribute is compared with, objects with a type, value and label. An empty label is set to the value.",
+			AttributeTypes: attachmentRuleValueAttributeTypes,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f attachmentRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var operator, attribute string
+	var values []attachmentRuleValue
+	resp.Error = req.Arguments.Get(ctx, &operator, &attribute, &values)
+	if resp.Error != nil {
+		return
+	}
+
+	rule, err := attachmentRule(operator, attribute, values)
+	if err != nil {
+		resp.Error = err
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, rule)
+}
+
+// attachmentRule returns the JSON encoded rule, normalized the same way as the runbook's
+// attributes so it never shows up as a diff
+func attachmentRule(operator, attribute string, values []attachmentRuleValue) (string, *function.FuncError) {
+	arguments, ok := attachmentRuleOperators[operator]
+	if !ok {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("unsupported operator %q", operator))
+	}
+	if strings.TrimSpace(attribute) == "" {
+		return "", function.NewArgumentFuncError(1, "attribute must not be empty")
+	}
+	for i, v := range values {
+		if v.Type == "" || v.Value == "" {
+			return "", function.NewArgumentFuncError(int64(2+i), "values must have a type and a value")
+		}
+		if v.Label == "" {
+			values[i].Label = v.Value
+		}
+	}
+
+	var rule string
+	switch arguments {
+	case attachmentRuleNoArgument:
+		if len(values) > 0 {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("operator %s doesn't take values", operator))
+		}
+		if operator == "manually" {
+			if attribute != "when_invoked" {
+				return "", function.NewArgumentFuncError(1, fmt.Sprintf("operator manually only applies to when_invoked, got: %s", attribute))
+			}
+			rule = firehydrant.RunbookAttachmentRuleDefaultJSON
+			break
+		}
+		rule = mustMarshalRule(map[string]interface{}{
+			"logic":     map[string]interface{}{operator: []interface{}{map[string]interface{}{"var": attribute}}},
+			"user_data": map[string]interface{}{},
+		})
+	case attachmentRuleOneArgument:
+		if len(values) != 1 {
+			return "", function.NewFuncError(fmt.Sprintf("operator %s takes exactly one value, got %d", operator, len(values)))
+		}
+		rule = attachmentRuleComparison(operator, attribute, values[0])
+	case attachmentRuleCollection:
+		if len(values) == 0 {
+			return "", function.NewFuncError(fmt.Sprintf("operator %s takes at least one value", operator))
+		}
+		for i, v := range values[1:] {
+			if v.Type != values[0].Type {
+				return "", function.NewArgumentFuncError(int64(3+i), fmt.Sprintf("values must all have the same type, got %s and %s", values[0].Type, v.Type))
+			}
+		}
+		rule = attachmentRuleComparison(operator, attribute, map[string]interface{}{
+			"type":  fmt.Sprintf("Array[%s]", values[0].Type),
+			"value": values,
+			"label": nil,
+		})
+	}
+
+	normalized, err := structure.NormalizeJsonString(rule)
+	if err != nil {
+		return "", function.NewFuncError(err.Error())
+	}
+	return normalized, nil
+}
+
+// attachmentRuleComparison returns a rule comparing the attribute with the user data
+func attachmentRuleComparison(operator, attribute string, userData interface{}) string {
+	return mustMarshalRule(map[string]interface{}{
+		"logic": map[string]interface{}{
+			operator: []interface{}{
+				map[string]interface{}{"var": attribute},
+				map[string]interface{}{"var": "usr.1"},
+			},
+		},
+		"user_data": map[string]interface{}{"1": userData},
+	})
+}
+
+func mustMarshalRule(rule map[string]interface{}) string {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
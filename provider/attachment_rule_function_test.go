@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func TestAttachmentRuleFunction(t *testing.T) {
+	valueType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":  tftypes.String,
+		"value": tftypes.String,
+		"label": tftypes.String,
+	}}
+	value := func(typ, v, label string) tftypes.Value {
+		return tftypes.NewValue(valueType, map[string]tftypes.Value{
+			"type":  tftypes.NewValue(tftypes.String, typ),
+			"value": tftypes.NewValue(tftypes.String, v),
+			"label": tftypes.NewValue(tftypes.String, label),
+		})
+	}
+	defaultRule, _ := structure.NormalizeJsonString(firehydrant.RunbookAttachmentRuleDefaultJSON)
+
+	tests := map[string]struct {
+		operator, attribute string
+		values              []tftypes.Value
+		expected            string
+		err                 string
+	}{
+		"manually": {
+			operator:  "manually",
+			attribute: "when_invoked",
+			expected:  defaultRule,
+		},
+		"exists": {
+			operator:  "exists",
+			attribute: "incident_slack_channel",
+			expected:  `{"logic":{"exists":[{"var":"incident_slack_channel"}]},"user_data":{}}`,
+		},
+		"eq": {
+			operator:  "eq",
+			attribute: "incident_current_milestone",
+			values:    []tftypes.Value{value("Milestone", "resolved", "Resolved")},
+			expected:  `{"logic":{"eq":[{"var":"incident_current_milestone"},{"var":"usr.1"}]},"user_data":{"1":{"label":"Resolved","type":"Milestone","value":"resolved"}}}`,
+		},
+		"includes_any": {
+			operator:  "includes_any",
+			attribute: "incident_assigned_roles",
+			values:    []tftypes.Value{value("IncidentRole", "role-1", "Commander"), value("IncidentRole", "role-2", "")},
+			expected:  `{"logic":{"includes_any":[{"var":"incident_assigned_roles"},{"var":"usr.1"}]},"user_data":{"1":{"label":null,"type":"Array[IncidentRole]","value":[{"label":"Commander","type":"IncidentRole","value":"role-1"},{"label":"role-2","type":"IncidentRole","value":"role-2"}]}}}`,
+		},
+		"unsupported operator": {
+			operator:  "matches",
+			attribute: "incident_slack_channel",
+			err:       `unsupported operator "matches"`,
+		},
+		"manually with another attribute": {
+			operator:  "manually",
+			attribute: "incident_slack_channel",
+			err:       "only applies to when_invoked",
+		},
+		"missing value": {
+			operator:  "eq",
+			attribute: "incident_current_milestone",
+			err:       "takes exactly one value",
+		},
+		"unexpected value": {
+			operator:  "exists",
+			attribute: "incident_slack_channel",
+			values:    []tftypes.Value{value("Milestone", "resolved", "Resolved")},
+			err:       "doesn't take values",
+		},
+		"mixed types": {
+			operator:  "is_one_of",
+			attribute: "incident_current_milestone",
+			values:    []tftypes.Value{value("Milestone", "started", ""), value("Severity", "SEV1", "")},
+			err:       "must all have the same type",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			arguments := append([]tftypes.Value{
+				tftypes.NewValue(tftypes.String, tc.operator),
+				tftypes.NewValue(tftypes.String, tc.attribute),
+			}, tc.values...)
+			result, funcErr := testCallFunction(t, "attachment_rule", arguments...)
+			if tc.err != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, funcErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			var got string
+			if err := result.As(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// celSignal declares the fields of the signal variable of signal rule expressions. It mirrors
+// the Signal schema of the Signals debugger (POST /v1/signals/debugger, components.Signal in
+// firehydrant-go-sdk), with the status, idempotency key and labels of ingested events that
+// the schema leaves out. Sample signals are decoded into it from JSON to evaluate expressions
+// against them.
+type celSignal struct {
+	ID             string            `cel:"id" json:"id"`
+	OrganizationID string            `cel:"organization_id" json:"organization_id"`
+	Summary        string            `cel:"summary" json:"summary"`
+	Body           string            `cel:"body" json:"body"`
+	Level          string            `cel:"level" json:"level"`
+	Status         string            `cel:"status" json:"status"`
+	IdempotencyKey string            `cel:"idempotency_key" json:"idempotency_key"`
+	Labels         map[string]string `cel:"labels" json:"labels"`
+	Annotations    map[string]string `cel:"annotations" json:"annotations"`
+	Tags           []string          `cel:"tags" json:"tags"`
+	Images         []celSignalImage  `cel:"images" json:"images"`
+	Links          []celSignalLink   `cel:"links" json:"links"`
+}
+
+type celSignalImage struct {
+	Src string `cel:"src" json:"src"`
+	Alt string `cel:"alt" json:"alt"`
+}
+
+type celSignalLink struct {
+	Href string `cel:"href" json:"href"`
+	Text string `cel:"text" json:"text"`
+}
+
+// celEmail declares the fields of the email variable of inbound email expressions
+type celEmail struct {
+	Subject string `cel:"subject"`
+	Body    string `cel:"body"`
+	From    string `cel:"from"`
+	To      string `cel:"to"`
+}
+
+var (
+	// signalRuleCELEnv checks the expression of signal rules
+	signalRuleCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+		return newCELEnv("signal", reflect.TypeOf(celSignal{}), reflect.TypeOf(celSignalImage{}), reflect.TypeOf(celSignalLink{}))
+	})
+	// inboundEmailCELEnv checks the status and level expressions of inbound emails
+	inboundEmailCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+		return newCELEnv("email", reflect.TypeOf(celEmail{}))
+	})
+)
+
+// newCELEnv declares a CEL environment with a single variable of the first of the given
+// types, which can refer to the others. Like the Signals API, it includes the cel-go
+// extension libraries.
+func newCELEnv(variable string, types ...reflect.Type) (*cel.Env, error) {
+	nativeTypes := []any{ext.ParseStructTags(true)}
+	for _, t := range types {
+		nativeTypes = append(nativeTypes, t)
+	}
+	return cel.NewEnv(
+		ext.NativeTypes(nativeTypes...),
+		cel.Variable(variable, cel.ObjectType("provider."+types[0].Name())),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+		ext.Math(),
+		ext.Encoders(),
+	)
+}
+
+// validateCELExpression checks that an expression compiles in the environment and evaluates
+// to the given type, so syntax and type errors are reported at plan time rather than when
+// the API rejects the expression. Fields the environment doesn't declare are only a warning,
+// as the API may know fields the environment is missing.
+func validateCELExpression(env func() (*cel.Env, error), outputType *cel.Type) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		e, err := env()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		ast, issues := e.Compile(v.(string))
+		if issues.Err() != nil && undefinedFieldsOnly(issues) {
+			return diag.Diagnostics{{
+				Severity:      diag.Warning,
+				Summary:       "Unknown field in CEL expression",
+				Detail:        fmt.Sprintf("%s\n\nThe provider doesn't know this field, so the expression is left for the API to check.", issues.Err()),
+				AttributePath: path,
+			}}
+		}
+		if issues.Err() != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid CEL expression",
+				Detail:        issues.Err().Error(),
+				AttributePath: path,
+			}}
+		}
+		if t := ast.OutputType(); t != cel.DynType && !outputType.IsAssignableType(t) {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid CEL expression",
+				Detail:        fmt.Sprintf("The expression must evaluate to %s, got %s", outputType, t),
+				AttributePath: path,
+			}}
+		}
+		return nil
+	}
+}
+
+// undefinedFieldsOnly reports whether every issue is a field the environment doesn't declare
+func undefinedFieldsOnly(issues *cel.Issues) bool {
+	for _, err := range issues.Errors() {
+		if !strings.HasPrefix(err.Message, "undefined field ") {
+			return false
+		}
+	}
+	return len(issues.Errors()) > 0
+}
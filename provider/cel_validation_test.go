@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestValidateCELExpression(t *testing.T) {
+	cases := []struct {
+		name       string
+		env        func() (*cel.Env, error)
+		outputType *cel.Type
+		expression string
+		err        string
+		warning    string
+	}{
+		{name: "summary", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summary.contains("[Triggered]")`},
+		{name: "labels", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.labels["service"] == "payments" && signal.level == "ERROR"`},
+		{name: "tags", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `"prod" in signal.tags || signal.links.exists(l, l.href.startsWith("https://"))`},
+		{name: "string extension", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summary.lowerAscii().contains("down")`},
+		{name: "syntax error", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summary ==`, err: "Syntax error"},
+		{name: "unknown field", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summry == "x"`, warning: "undefined field 'summry'"},
+		{name: "unknown field and type error", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summry == "x" && signal.summary == 1`, err: "no matching overload"},
+		{name: "unknown variable", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `email.body.contains("x")`, err: "undeclared reference to 'email'"},
+		{name: "type error", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summary == 1`, err: "no matching overload"},
+		{name: "not a bool", env: signalRuleCELEnv, outputType: cel.BoolType, expression: `signal.summary`, err: "must evaluate to bool, got string"},
+		{name: "status", env: inboundEmailCELEnv, outputType: cel.StringType, expression: `email.body.contains('has recovered') ? 'CLOSED' : 'OPEN'`},
+		{name: "level", env: inboundEmailCELEnv, outputType: cel.StringType, expression: `email.subject.contains('panic') ? 'ERROR' : 'INFO'`},
+		{name: "not a string", env: inboundEmailCELEnv, outputType: cel.StringType, expression: `email.body.contains('panic')`, err: "must evaluate to string, got bool"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := cty.GetAttrPath("expression")
+			diags := validateCELExpression(c.env, c.outputType)(c.expression, path)
+			if c.warning != "" {
+				if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, c.warning) {
+					t.Fatalf("expected a warning containing %q, got %v", c.warning, diags)
+				}
+				return
+			}
+			if c.err == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || !strings.Contains(diags[0].Detail, c.err) {
+				t.Fatalf("expected an error containing %q, got %v", c.err, diags)
+			}
+			if !diags[0].AttributePath.Equals(path) {
+				t.Fatalf("expected the error to be reported for %v, got %v", path, diags[0].AttributePath)
+			}
+		})
+	}
+}
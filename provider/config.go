@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultBaseURL = "https://api.firehydrant.io/v1/"
+
+// providerConfig is the configuration of the provider block, with the defaults taken from
+// the environment already applied. Both the plugin SDK and the plugin framework provider
+// read their configuration into it, so they configure the same API client.
+type providerConfig struct {
+	apiKey             string
+	apiKeyFile         string
+	apiKeyCommand      []string
+	baseURL            string
+	maxRetries         int
+	retryMaxWait       string
+	requestsPerSecond  float64
+	proxyURL           string
+	caCertPEM          string
+	caCertFile         string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+	readCache          bool
+	defaultLabels      map[string]string
+}
+
+// providerConfigFromResourceData reads the configuration of the plugin SDK provider, which
+// applies the defaults of its schema itself
+func providerConfigFromResourceData(rd *schema.ResourceData) providerConfig {
+	var apiKeyCommand []string
+	for _, arg := range rd.Get(apiKeyCommandName).([]interface{}) {
+		s, _ := arg.(string)
+		apiKeyCommand = append(apiKeyCommand, s)
+	}
+
+	return providerConfig{
+		apiKey:             rd.Get(apiKeyName).(string),
+		apiKeyFile:         rd.Get(apiKeyFileName).(string),
+		apiKeyCommand:      apiKeyCommand,
+		baseURL:            rd.Get(firehydrantBaseURLName).(string),
+		maxRetries:         rd.Get(maxRetriesName).(int),
+		retryMaxWait:       rd.Get(retryMaxWaitName).(string),
+		requestsPerSecond:  rd.Get(requestsPerSecondName).(float64),
+		proxyURL:           rd.Get(proxyURLName).(string),
+		caCertPEM:          rd.Get(caCertPEMName).(string),
+		caCertFile:         rd.Get(caCertFileName).(string),
+		clientCert:         rd.Get(clientCertName).(string),
+		clientKey:          rd.Get(clientKeyName).(string),
+		insecureSkipVerify: rd.Get(insecureSkipVerifyName).(bool),
+		readCache:          rd.Get(readCacheName).(bool),
+		defaultLabels:      convertStringMap(rd.Get(defaultLabelsName).(map[string]interface{})),
+	}
+}
+
+// configuredClient hands out the API client of the provider. Terraform configures the plugin
+// SDK and the plugin framework provider one after the other with the same configuration, and
+// they share the client built for the first, so resources of both share its rate limit and
+// read cache, and the API is only pinged once.
+type configuredClient struct {
+	apiKeys apiKeyCache
+
+	mu     sync.Mutex
+	config *providerConfig
+	client *firehydrant.APIClient
+
+	// fixed, when set, is handed out for every configuration. Acceptance tests use it to
+	// share one client between all their providers.
+	fixed *firehydrant.APIClient
+}
+
+// get returns the API client for config, building and pinging a new one unless the last
+// call was for the same configuration
+func (c *configuredClient) get(ctx context.Context, config providerConfig, terraformVersion string) (*firehydrant.APIClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fixed != nil {
+		c.client = c.fixed
+		return c.fixed, nil
+	}
+	if c.client != nil && reflect.DeepEqual(*c.config, config) {
+		return c.client, nil
+	}
+
+	client, err := newAPIClient(ctx, config, terraformVersion, &c.apiKeys)
+	if err != nil {
+		return nil, err
+	}
+	c.config, c.client = &config, client
+	return client, nil
+}
+
+// current returns the API client of the last configuration, nil until the provider was
+// configured
+func (c *configuredClient) current() *firehydrant.APIClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.client
+}
+
+func newAPIClient(ctx context.Context, config providerConfig, terraformVersion string, apiKeys *apiKeyCache) (*firehydrant.APIClient, error) {
+	apiKey, err := resolveAPIKey(ctx, config, apiKeys)
+	if err != nil {
+		return nil, err
+	}
+	retryMaxWait, err := time.ParseDuration(config.retryMaxWait)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", retryMaxWaitName, err)
+	}
+
+	transportOpts, err := transportOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ac, err := firehydrant.NewRestClient(apiKey, append([]firehydrant.OptFunc{
+		firehydrant.WithBaseURL(config.baseURL),
+		firehydrant.WithUserAgentSuffix(fmt.Sprintf("terraform-%s", terraformVersion)),
+		firehydrant.WithMaxRetries(config.maxRetries),
+		firehydrant.WithRetryMaxWait(retryMaxWait),
+		firehydrant.WithRequestsPerSecond(config.requestsPerSecond),
+		firehydrant.WithReadCache(config.readCache),
+		firehydrant.WithDefaultLabels(config.defaultLabels),
+	}, transportOpts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize API client: %w", err)
+	}
+
+	_, err = ac.Ping(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// We're getting 429s during tests, so the var here is intended to reduce overall API calls.  The old provider, horrifyingly, seems
+	// to do part of its setup during this Ping() call, so we won't disable that one, but just cutting the pings in half should be sufficient.
+	if os.Getenv("TF_ACC") == "" {
+		_, err = ac.Sdk.AccountSettings.Ping(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ac, nil
+}
+
+// transportOptions returns the client options for the proxy and TLS settings of the provider
+func transportOptions(config providerConfig) ([]firehydrant.OptFunc, error) {
+	var opts []firehydrant.OptFunc
+	if config.proxyURL != "" {
+		opts = append(opts, firehydrant.WithProxyURL(config.proxyURL))
+	}
+
+	if config.caCertPEM != "" {
+		opts = append(opts, firehydrant.WithCACertPEM([]byte(config.caCertPEM)))
+	}
+	if config.caCertFile != "" {
+		caCertPEM, err := os.ReadFile(config.caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", caCertFileName, err)
+		}
+		opts = append(opts, firehydrant.WithCACertPEM(caCertPEM))
+	}
+
+	if config.clientCert != "" {
+		opts = append(opts, firehydrant.WithClientCertificate([]byte(config.clientCert), []byte(config.clientKey)))
+	}
+	if config.insecureSkipVerify {
+		opts = append(opts, firehydrant.WithInsecureSkipVerify(true))
+	}
+	return opts, nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apiKeyFileName    = "api_key_file"
+	apiKeyCommandName = "api_key_command"
+
+	// apiKeyCommandTimeout is how long api_key_command may run before it's killed
+	apiKeyCommandTimeout = time.Minute
+)
+
+// apiKeyCache remembers the API keys returned by api_key_command, so the command runs once
+// for the lifetime of the provider rather than every time the provider is configured
+type apiKeyCache struct {
+	mu   sync.Mutex
+	keys map[string]string
+}
+
+// resolveAPIKey returns the API key from the single credential source in use. Sources set
+// in the provider configuration conflict with each other and win over the environment,
+// where FIREHYDRANT_API_KEY wins over FIREHYDRANT_API_KEY_FILE.
+func resolveAPIKey(ctx context.Context, config providerConfig, cache *apiKeyCache) (string, error) {
+	if config.apiKey != "" {
+		return config.apiKey, nil
+	}
+	if config.apiKeyFile != "" {
+		return readAPIKeyFile(apiKeyFileName, config.apiKeyFile)
+	}
+	if len(config.apiKeyCommand) > 0 {
+		return cache.run(ctx, config.apiKeyCommand)
+	}
+
+	if apiKey := os.Getenv("FIREHYDRANT_API_KEY"); apiKey != "" {
+		return apiKey, nil
+	}
+	if path := os.Getenv("FIREHYDRANT_API_KEY_FILE"); path != "" {
+		return readAPIKeyFile("FIREHYDRANT_API_KEY_FILE", path)
+	}
+
+	return "", fmt.Errorf("no API key configured, set one of %s, %s or %s, or the FIREHYDRANT_API_KEY or FIREHYDRANT_API_KEY_FILE environment variables",
+		apiKeyName, apiKeyFileName, apiKeyCommandName)
+}
+
+func readAPIKeyFile(source, path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read API key from %s: %w", source, err)
+	}
+
+	apiKey := strings.TrimSpace(string(b))
+	if apiKey == "" {
+		return "", fmt.Errorf("%s %s is empty", source, path)
+	}
+	return apiKey, nil
+}
+
+// run returns the API key printed by the command, running it only the first time
+func (c *apiKeyCache) run(ctx context.Context, args []string) (string, error) {
+	key := strings.Join(args, "\x00")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if apiKey, ok := c.keys[key]; ok {
+		return apiKey, nil
+	}
+
+	if args[0] == "" {
+		return "", fmt.Errorf("%s must start with the program to run", apiKeyCommandName)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s failed: %w: %s", apiKeyCommandName, err, msg)
+		}
+		return "", fmt.Errorf("%s failed: %w", apiKeyCommandName, err)
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		return "", fmt.Errorf("%s printed no API key", apiKeyCommandName)
+	}
+
+	if c.keys == nil {
+		c.keys = map[string]string{}
+	}
+	c.keys[key] = apiKey
+	return apiKey, nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResolveAPIKey(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "api-key")
+	if err := os.WriteFile(keyFile, []byte("key-from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]interface{}
+		env           map[string]string
+		expected      string
+		expectedError string
+	}{
+		{name: "api_key", config: map[string]interface{}{"api_key": "key-from-config"}, env: map[string]string{"FIREHYDRANT_API_KEY": "key-from-env"}, expected: "key-from-config"},
+		{name: "api_key_file", config: map[string]interface{}{"api_key_file": keyFile}, env: map[string]string{"FIREHYDRANT_API_KEY": "key-from-env"}, expected: "key-from-file"},
+		{name: "api_key_command", config: map[string]interface{}{"api_key_command": []interface{}{"echo", "key-from-command"}}, expected: "key-from-command"},
+		{name: "environment", env: map[string]string{"FIREHYDRANT_API_KEY": "key-from-env", "FIREHYDRANT_API_KEY_FILE": keyFile}, expected: "key-from-env"},
+		{name: "environment file", env: map[string]string{"FIREHYDRANT_API_KEY_FILE": keyFile}, expected: "key-from-file"},
+		{name: "nothing configured", expectedError: "no API key configured"},
+		{name: "missing file", config: map[string]interface{}{"api_key_file": filepath.Join(dir, "missing")}, expectedError: "could not read API key from api_key_file"},
+		{name: "empty file", config: map[string]interface{}{"api_key_file": emptyFile}, expectedError: "is empty"},
+		{name: "failing command", config: map[string]interface{}{"api_key_command": []interface{}{"sh", "-c", "echo denied >&2; exit 1"}}, expectedError: "api_key_command failed: exit status 1: denied"},
+		{name: "silent command", config: map[string]interface{}{"api_key_command": []interface{}{"true"}}, expectedError: "printed no API key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FIREHYDRANT_API_KEY", tt.env["FIREHYDRANT_API_KEY"])
+			t.Setenv("FIREHYDRANT_API_KEY_FILE", tt.env["FIREHYDRANT_API_KEY_FILE"])
+
+			config := tt.config
+			if config == nil {
+				config = map[string]interface{}{}
+			}
+			rd := schema.TestResourceDataRaw(t, Provider().Schema, config)
+
+			apiKey, err := resolveAPIKey(context.Background(), providerConfigFromResourceData(rd), &apiKeyCache{})
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Received error resolving API key: %s", err.Error())
+			}
+			if apiKey != tt.expected {
+				t.Fatalf("Expected API key %q, got %q", tt.expected, apiKey)
+			}
+		})
+	}
+}
+
+func TestResolveAPIKeyCachesCommand(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "runs")
+	config := map[string]interface{}{
+		"api_key_command": []interface{}{"sh", "-c", "echo run >> " + counter + "; echo key-from-command"},
+	}
+	rd := schema.TestResourceDataRaw(t, Provider().Schema, config)
+
+	cache := &apiKeyCache{}
+	for i := 0; i < 3; i++ {
+		if _, err := resolveAPIKey(context.Background(), providerConfigFromResourceData(rd), cache); err != nil {
+			t.Fatalf("Received error resolving API key: %s", err.Error())
+		}
+	}
+
+	runs, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, got := 1, strings.Count(string(runs), "run"); expected != got {
+		t.Fatalf("Expected the command to run %d time, ran %d times", expected, got)
+	}
+}
+
+func TestProviderAPIKeySourcesConflict(t *testing.T) {
+	diags := Provider().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":      "key-from-config",
+		"api_key_file": "/tmp/api-key",
+	}))
+	if !diags.HasError() {
+		t.Fatalf("Expected api_key and api_key_file to conflict")
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +18,9 @@ func resourceCustomEventSource() *schema.Resource {
 		ReadContext:   readResourceCustomEventSource,
 		UpdateContext: updateResourceCustomEventSource,
 		DeleteContext: deleteResourceCustomEventSource,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: customEventSourceImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -79,6 +80,13 @@ func readResourceCustomEventSource(ctx context.Context, d *schema.ResourceData,
 
 	response, err := client.Sdk.Signals.GetSignalsEventSource(ctx, slug)
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Custom event source %s no longer exists", slug), map[string]interface{}{
+				"slug": slug,
+			})
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -133,7 +141,7 @@ func deleteResourceCustomEventSource(ctx context.Context, d *schema.ResourceData
 	})
 	err := client.Sdk.Signals.DeleteSignalsEventSource(ctx, slug)
 	if err != nil {
-		if err.(*sdkerrors.SDKError).StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -142,3 +150,27 @@ func deleteResourceCustomEventSource(ctx context.Context, d *schema.ResourceData
 
 	return diag.Diagnostics{}
 }
+
+// customEventSourceImportLookup resolves name=<name> imports. The ID of an event source is its
+// slug, so slug=<slug> needs no lookup.
+var customEventSourceImportLookup = importLookup{
+	resourceName: "custom event source",
+	fields:       []string{"name", "slug"},
+	slugIsID:     true,
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := firehydrant.TransposersParams{}
+		return listImportCandidates(ctx, client, &request,
+			func(request *firehydrant.TransposersParams, page *int) { request.Page = *page },
+			func(ctx context.Context, request *firehydrant.TransposersParams) (pagination.PaginateResponse[firehydrant.Transposer], error) {
+				response, err := client.Transposers().Get(ctx, *request)
+				if err != nil {
+					return nil, err
+				}
+				return pagination.RESTPage[firehydrant.Transposer]{Data: response.Transposers, Pagination: response.Pagination}, nil
+			},
+			func(transposer firehydrant.Transposer) importCandidate {
+				return importCandidate{ID: transposer.Slug, Name: transposer.Name, Slug: transposer.Slug}
+			},
+		)
+	},
+}
@@ -14,9 +14,9 @@ func TestAccCustomEventSourceResource_basic(t *testing.T) {
 	t.Parallel()
 	slug := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckCustomEventSourceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckCustomEventSourceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCustomEventSourceResourceConfig_basic(slug),
@@ -42,9 +42,9 @@ func TestAccCustomEventSourceResource_update(t *testing.T) {
 	t.Parallel()
 	slug := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckCustomEventSourceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckCustomEventSourceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCustomEventSourceResourceConfig_basic(slug),
@@ -175,9 +175,9 @@ func TestAccCustomEventSourceResourceImport_basic(t *testing.T) {
 	t.Parallel()
 	slug := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckCustomEventSourceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckCustomEventSourceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCustomEventSourceResourceConfig_basic(slug),
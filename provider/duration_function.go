@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/senseyeio/duration"
+)
+
+// durationFunction builds the ISO8601 durations used by runbook steps' repeats_duration,
+// signal rules' deduplication_expiry and rotations' shift_duration
+type durationFunction struct{}
+
+var _ function.Function = durationFunction{}
+
+func newDurationFunction() function.Function {
+	return durationFunction{}
+}
+
+func (f durationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration"
+}
+
+func (f durationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an ISO8601 duration",
+		Description: "Returns the ISO8601 duration of the given hours and minutes, e.g. `PT1H30M` for 1 hour and 30 minutes, " +
+			"for repeats_duration, deduplication_expiry and shift_duration. Minutes over 59 are carried over to hours.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{Name: "hours", Description: "Number of hours"},
+			function.Int64Parameter{Name: "minutes", Description: "Number of minutes"},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f durationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hours, minutes int64
+	resp.Error = req.Arguments.Get(ctx, &hours, &minutes)
+	if resp.Error != nil {
+		return
+	}
+	if hours < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("hours must not be negative, got: %d", hours))
+		return
+	}
+	if minutes < 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("minutes must not be negative, got: %d", minutes))
+		return
+	}
+	total := hours*60 + minutes
+	if total == 0 {
+		resp.Error = function.NewFuncError("duration must be longer than zero")
+		return
+	}
+
+	// Parsed again, so the value is one the resources themselves accept
+	d, err := duration.ParseISO8601(duration.Duration{TH: int(total / 60), TM: int(total % 60)}.String())
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, d.String())
+}
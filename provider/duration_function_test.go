@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDurationFunction(t *testing.T) {
+	tests := map[string]struct {
+		hours, minutes int64
+		expected       string
+		err            string
+	}{
+		"hours and minutes": {hours: 1, minutes: 30, expected: "PT1H30M"},
+		"hours":             {hours: 8, expected: "PT8H"},
+		"minutes":           {minutes: 15, expected: "PT15M"},
+		"carried minutes":   {minutes: 90, expected: "PT1H30M"},
+		"zero":              {err: "longer than zero"},
+		"negative hours":    {hours: -1, minutes: 30, err: "hours must not be negative"},
+		"negative minutes":  {hours: 1, minutes: -30, err: "minutes must not be negative"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := testCallFunction(t, "duration", tftypes.NewValue(tftypes.Number, tc.hours), tftypes.NewValue(tftypes.Number, tc.minutes))
+			if tc.err != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, funcErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			var got string
+			if err := result.As(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,7 +45,7 @@ func dataFireHydrantEnvironment(ctx context.Context, d *schema.ResourceData, m i
 	})
 	environmentResponse, err := client.Sdk.CatalogEntries.GetEnvironment(ctx, environmentID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return diag.Errorf("Environment %s not found", environmentID)
 		}
 		return diag.Errorf("Error reading environment %s: %v", environmentID, err)
@@ -13,15 +13,15 @@ func TestAccEnvironmentDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rName)),
+						"data.firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rName)),
 				),
 			},
 		},
@@ -33,15 +33,15 @@ func TestAccEnvironmentDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rName)),
+						"data.firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_environment.test_environment", "description", fmt.Sprintf("test-description-%s", rName)),
 				),
@@ -53,7 +53,7 @@ func TestAccEnvironmentDataSource_allAttributes(t *testing.T) {
 func testAccEnvironmentDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_environment" "test_environment" {
-  name    = "test-environment-%s"
+  name    = "tf-acc-environment-%s"
 }
 
 data "firehydrant_environment" "test_environment" {
@@ -64,7 +64,7 @@ data "firehydrant_environment" "test_environment" {
 func testAccEnvironmentDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_environment" "test_environment" {
-  name        = "test-environment-%s"
+  name        = "tf-acc-environment-%s"
   description = "test-description-%s"
 }
 
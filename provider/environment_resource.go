@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,8 +21,9 @@ func resourceEnvironment() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantEnvironment,
 		ReadContext:   readResourceFireHydrantEnvironment,
 		DeleteContext: deleteResourceFireHydrantEnvironment,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: environmentImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -50,7 +52,7 @@ func readResourceFireHydrantEnvironment(ctx context.Context, d *schema.ResourceD
 	})
 	environmentResponse, err := client.Sdk.CatalogEntries.GetEnvironment(ctx, environmentID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Environment %s no longer exists", environmentID), map[string]interface{}{
 				"id": environmentID,
 			})
@@ -142,7 +144,7 @@ func deleteResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 	})
 	err := client.Sdk.CatalogEntries.DeleteEnvironment(ctx, environmentID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting environment %s: %v", environmentID, err)
@@ -150,3 +152,23 @@ func deleteResourceFireHydrantEnvironment(ctx context.Context, d *schema.Resourc
 
 	return diag.Diagnostics{}
 }
+
+var environmentImportLookup = importLookup{
+	resourceName: "environment",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListEnvironmentsRequest{PerPage: ptr.Of(importLookupPerPage)}
+		if field == "name" {
+			request.Name = &value
+		}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListEnvironmentsRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListEnvironmentsRequest) (pagination.PaginateResponse[components.EnvironmentEntryEntity], error) {
+				return client.Sdk.CatalogEntries.ListEnvironments(ctx, request.Page, request.PerPage, request.Query, request.Name)
+			},
+			func(environment components.EnvironmentEntryEntity) importCandidate {
+				return importCandidate{ID: stringValue(environment.GetID()), Name: stringValue(environment.GetName()), Slug: stringValue(environment.GetSlug())}
+			},
+		)
+	},
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -15,9 +16,9 @@ func TestAccEnvironmentResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckEnvironmentResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckEnvironmentResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentResourceConfig_basic(rName),
@@ -25,7 +26,7 @@ func TestAccEnvironmentResource_basic(t *testing.T) {
 					testAccCheckEnvironmentResourceExistsWithAttributes_basic("firehydrant_environment.test_environment"),
 					resource.TestCheckResourceAttrSet("firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rName)),
+						"firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rName)),
 				),
 			},
 		},
@@ -38,9 +39,9 @@ func TestAccEnvironmentResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckEnvironmentResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckEnvironmentResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentResourceConfig_basic(rName),
@@ -48,7 +49,7 @@ func TestAccEnvironmentResource_update(t *testing.T) {
 					testAccCheckEnvironmentResourceExistsWithAttributes_basic("firehydrant_environment.test_environment"),
 					resource.TestCheckResourceAttrSet("firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rName)),
+						"firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rName)),
 				),
 			},
 			{
@@ -57,7 +58,7 @@ func TestAccEnvironmentResource_update(t *testing.T) {
 					testAccCheckEnvironmentResourceExistsWithAttributes_update("firehydrant_environment.test_environment"),
 					resource.TestCheckResourceAttrSet("firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rNameUpdated)),
+						"firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_environment.test_environment", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 				),
@@ -68,7 +69,7 @@ func TestAccEnvironmentResource_update(t *testing.T) {
 					testAccCheckEnvironmentResourceExistsWithAttributes_basic("firehydrant_environment.test_environment"),
 					resource.TestCheckResourceAttrSet("firehydrant_environment.test_environment", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_environment.test_environment", "name", fmt.Sprintf("test-environment-%s", rNameUpdated)),
+						"firehydrant_environment.test_environment", "name", fmt.Sprintf("tf-acc-environment-%s", rNameUpdated)),
 				),
 			},
 		},
@@ -80,8 +81,8 @@ func TestAccEnvironmentResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentResourceConfig_basic(rName),
@@ -100,8 +101,8 @@ func TestAccEnvironmentResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEnvironmentResourceConfig_update(rName),
@@ -115,6 +116,33 @@ func TestAccEnvironmentResourceImport_allAttributes(t *testing.T) {
 	})
 }
 
+func TestOfflineEnvironmentResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	testFakeAPICase(t, server, "firehydrant_environment", "/v1/environments", []resource.TestStep{
+		{
+			Config: testAccEnvironmentResourceConfig_basic("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_environment.test_environment", "id"),
+				resource.TestCheckResourceAttr("firehydrant_environment.test_environment", "name", "tf-acc-environment-offline"),
+			),
+		},
+		{
+			Config: testAccEnvironmentResourceConfig_update("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_environment.test_environment", "description", "test-description-offline"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_environment.test_environment",
+			ImportStateId:     "name=tf-acc-environment-offline",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccCheckEnvironmentResourceExistsWithAttributes_basic(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		environmentResource, ok := s.RootModule().Resources[resourceName]
@@ -211,14 +239,14 @@ func testAccCheckEnvironmentResourceDestroy() resource.TestCheckFunc {
 func testAccEnvironmentResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_environment" "test_environment" {
-  name    = "test-environment-%s"
+  name    = "tf-acc-environment-%s"
 }`, rName)
 }
 
 func testAccEnvironmentResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_environment" "test_environment" {
-  name        = "test-environment-%s"
+  name        = "tf-acc-environment-%s"
   description = "test-description-%s"
 }`, rName, rName)
 }
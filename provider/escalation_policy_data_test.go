@@ -23,12 +23,12 @@ data "firehydrant_escalation_policy" "test_escalation_policy" {
 func testAccEscalationPolicyDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team" {
-	name = "test-team-acc-escalation-%s"
+	name = "tf-acc-team-acc-escalation-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule" {
 	team_id = firehydrant_team.test_team.id
-	name = "test-on-call-schedule-escalation-%s"
+	name = "tf-acc-on-call-schedule-escalation-%s"
 	time_zone = "America/New_York"
 
 	strategy {
@@ -63,12 +63,12 @@ data "firehydrant_escalation_policy" "test_escalation_policy" {
 func testAccEscalationPolicyDataSourceConfig_dynamic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team" {
-	name = "test-team-acc-dynamic-%s"
+	name = "tf-acc-team-acc-dynamic-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule" {
 	team_id = firehydrant_team.test_team.id
-	name = "test-on-call-schedule-dynamic-%s"
+	name = "tf-acc-on-call-schedule-dynamic-%s"
 	time_zone = "America/New_York"
 
 	strategy {
@@ -138,8 +138,8 @@ func TestAccEscalationPolicyDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckEscalationPolicyResourceDestroy(),
 			testAccCheckOnCallScheduleResourceDestroy(),
@@ -168,8 +168,8 @@ func TestAccEscalationPolicyDataSource_dynamic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckEscalationPolicyResourceDestroy(),
 			testAccCheckOnCallScheduleResourceDestroy(),
@@ -230,8 +230,8 @@ func TestEscalationPolicyDataSource_OneMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testEscalationPolicyDataSourceConfig_basic(),
@@ -269,8 +269,8 @@ func TestEscalationPolicyDataSource_MultipleMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testEscalationPolicyDataSourceConfig_exactMatch(),
@@ -308,8 +308,8 @@ func TestEscalationPolicyDataSource_MultipleMatchesNoExactMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testEscalationPolicyDataSourceConfig_exactMatch(),
@@ -341,8 +341,8 @@ func TestEscalationPolicyDataSource_NoMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testEscalationPolicyDataSourceConfig_basic(),
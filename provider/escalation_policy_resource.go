@@ -2,295 +2,440 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceEscalationPolicy() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: createResourceFireHydrantEscalationPolicy,
-		UpdateContext: updateResourceFireHydrantEscalationPolicy,
-		ReadContext:   readResourceFireHydrantEscalationPolicy,
-		DeleteContext: deleteResourceFireHydrantEscalationPolicy,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+// escalationPolicyResource is implemented with the plugin framework. Its schema is the one
+// of the plugin SDK resource it replaced, and state written by that resource is upgraded
+// from version 0, see UpgradeState.
+type escalationPolicyResource struct {
+	client *firehydrant.APIClient
+}
+
+var (
+	_ resource.Resource                 = &escalationPolicyResource{}
+	_ resource.ResourceWithConfigure    = &escalationPolicyResource{}
+	_ resource.ResourceWithImportState  = &escalationPolicyResource{}
+	_ resource.ResourceWithUpgradeState = &escalationPolicyResource{}
+)
+
+func newEscalationPolicyResource() resource.Resource {
+	return &escalationPolicyResource{}
+}
+
+type escalationPolicyResourceModel struct {
+	ID                           types.String                      `tfsdk:"id"`
+	Name                         types.String                      `tfsdk:"name"`
+	Default                      types.Bool                        `tfsdk:"default"`
+	Description                  types.String                      `tfsdk:"description"`
+	TeamID                       types.String                      `tfsdk:"team_id"`
+	Repetitions                  types.Int64                       `tfsdk:"repetitions"`
+	Steps                        []escalationPolicyStepModel       `tfsdk:"step"`
+	HandoffStep                  []escalationPolicyHandoffModel    `tfsdk:"handoff_step"`
+	StepStrategy                 types.String                      `tfsdk:"step_strategy"`
+	NotificationPriorityPolicies []notificationPriorityPolicyModel `tfsdk:"notification_priority_policies"`
+	Timeouts                     timeouts.Value                    `tfsdk:"timeouts"`
+}
+
+type escalationPolicyStepModel struct {
+	Timeout    types.String                  `tfsdk:"timeout"`
+	Targets    []escalationPolicyTargetModel `tfsdk:"targets"`
+	Priorities types.List                    `tfsdk:"priorities"`
+}
+
+type escalationPolicyTargetModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+type escalationPolicyHandoffModel struct {
+	TargetType types.String `tfsdk:"target_type"`
+	TargetID   types.String `tfsdk:"target_id"`
+}
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
+type notificationPriorityPolicyModel struct {
+	Priority    types.String                   `tfsdk:"priority"`
+	Repetitions types.Int64                    `tfsdk:"repetitions"`
+	HandoffStep []escalationPolicyHandoffModel `tfsdk:"handoff_step"`
+}
+
+func (r *escalationPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation_policy"
+}
+
+func (r *escalationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	handoffStep := func(description string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Description: description,
+			Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"target_type": schema.StringAttribute{Required: true},
+					"target_id":   schema.StringAttribute{Required: true},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
 				Required: true,
 			},
-			"default": {
-				Type:     schema.TypeBool,
+			"default": schema.BoolAttribute{
 				Optional: true,
 			},
-			"description": {
-				Type:     schema.TypeString,
+			"description": schema.StringAttribute{
 				Optional: true,
 			},
-			"team_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			"team_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"repetitions": {
-				Type:     schema.TypeInt,
+			"repetitions": schema.Int64Attribute{
 				Required: true,
 			},
-			"step": {
-				Type:     schema.TypeList, // or TypeSet if ordering is not important
-				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"timeout": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"targets": {
-							Type:     schema.TypeList,
+			"step_strategy": schema.StringAttribute{
+				Optional:    true,
+				Description: "The strategy for handling steps in the escalation policy. Can be 'static' or 'dynamic_by_priority'.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"timeout": schema.StringAttribute{
 							Required: true,
-							MinItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
 						},
-						"priorities": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+						"priorities": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
 						},
 					},
-				},
-			},
-			"handoff_step": {
-				Type:     schema.TypeList,
-				Optional: true, // or Required based on your API
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"target_type": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"target_id": {
-							Type:     schema.TypeString,
-							Required: true,
+					Blocks: map[string]schema.Block{
+						"targets": schema.ListNestedBlock{
+							Validators: []validator.List{listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{Required: true},
+									"id":   schema.StringAttribute{Required: true},
+								},
+							},
 						},
 					},
 				},
 			},
-			"step_strategy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The strategy for handling steps in the escalation policy. Can be 'static' or 'dynamic_by_priority'.",
-			},
-			"notification_priority_policies": {
-				Type:        schema.TypeList,
-				Optional:    true,
+			"handoff_step": handoffStep(""),
+			"notification_priority_policies": schema.ListNestedBlock{
 				Description: "Priority-specific policies for dynamic escalation policies",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"priority": {
-							Type:     schema.TypeString,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.StringAttribute{
 							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
+							Validators: []validator.String{stringvalidator.OneOf(
 								string(firehydrant.NotificationPriorityHigh),
 								string(firehydrant.NotificationPriorityMedium),
 								string(firehydrant.NotificationPriorityLow),
-							}, false),
+							)},
 						},
-						"repetitions": {
-							Type:        schema.TypeInt,
+						"repetitions": schema.Int64Attribute{
 							Optional:    true,
 							Description: "Number of repetitions for this priority level",
 						},
-						"handoff_step": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Handoff step for this priority level",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"target_type": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"target_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
+					},
+					Blocks: map[string]schema.Block{
+						"handoff_step": handoffStep("Handoff step for this priority level"),
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// UpgradeState upgrades state written by the plugin SDK resource. The SDK couldn't tell an
+// attribute that wasn't configured from one set to its zero value, and stored both as the
+// zero value, e.g. an empty description. They are stored as null now, so configurations
+// that leave them out don't show a diff after upgrading.
+func (r *escalationPolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   escalationPolicySchemaV0(ctx),
+			StateUpgrader: upgradeEscalationPolicyStateV0,
+		},
+	}
+}
+
+// escalationPolicySchemaV0 is the schema of the plugin SDK resource, which only needs the
+// attribute types to read state written with it
+func escalationPolicySchemaV0(ctx context.Context) *schema.Schema {
+	handoffStep := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"target_type": schema.StringAttribute{Required: true},
+				"target_id":   schema.StringAttribute{Required: true},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"name":          schema.StringAttribute{Required: true},
+			"default":       schema.BoolAttribute{Optional: true},
+			"description":   schema.StringAttribute{Optional: true},
+			"team_id":       schema.StringAttribute{Required: true},
+			"repetitions":   schema.Int64Attribute{Required: true},
+			"step_strategy": schema.StringAttribute{Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"timeout":    schema.StringAttribute{Required: true},
+						"priorities": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					},
+					Blocks: map[string]schema.Block{
+						"targets": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{Required: true},
+									"id":   schema.StringAttribute{Required: true},
 								},
 							},
 						},
 					},
 				},
 			},
+			"handoff_step": handoffStep,
+			"notification_priority_policies": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"priority":    schema.StringAttribute{Required: true},
+						"repetitions": schema.Int64Attribute{Optional: true},
+					},
+					Blocks: map[string]schema.Block{
+						"handoff_step": handoffStep,
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
 
-func readResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Get the API client
-	client := m.(*firehydrant.APIClient)
+func upgradeEscalationPolicyStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var model escalationPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.keepUnset(ctx, escalationPolicyResourceModel{})
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *escalationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *escalationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state escalationPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	// Get the escalation policy
-	id := d.Id()
-	teamID := d.Get("team_id").(string)
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read refreshes the model from the API, reporting false if the policy no longer exists
+func (r *escalationPolicyResource) read(ctx context.Context, model *escalationPolicyResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id := model.ID.ValueString()
+	teamID := model.TeamID.ValueString()
 	tflog.Debug(ctx, "Read escalation policy", map[string]interface{}{
 		"id":      id,
 		"team_id": teamID,
 	})
 
-	escalationPolicy, err := client.Sdk.Signals.GetTeamEscalationPolicy(ctx, teamID, id)
+	prior := *model
+	escalationPolicy, err := r.client.Sdk.Signals.GetTeamEscalationPolicy(ctx, teamID, id)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Escalation Policy %s no longer exists", id), map[string]interface{}{
 				"id": id,
 			})
-			d.SetId("")
-			return nil
+			return false, nil
 		}
 
-		return diag.Errorf("Error reading escalation policy %s: %v", id, err)
+		diags.AddError(fmt.Sprintf("Error reading escalation policy %s", id), err.Error())
+		return false, diags
 	}
 
-	// Update the resource data
-	d.Set("name", *escalationPolicy.GetName())
-	d.Set("description", *escalationPolicy.GetDescription())
-	d.Set("default", *escalationPolicy.GetDefault())
-	d.Set("repetitions", *escalationPolicy.GetRepetitions())
-
-	// Set step strategy
-	if stepStrategy := escalationPolicy.GetStepStrategy(); stepStrategy != nil {
-		d.Set("step_strategy", *stepStrategy)
-	}
+	model.Name = types.StringPointerValue(escalationPolicy.GetName())
+	model.Description = types.StringValue(stringValue(escalationPolicy.GetDescription()))
+	model.Default = types.BoolValue(escalationPolicy.GetDefault() != nil && *escalationPolicy.GetDefault())
+	model.Repetitions = types.Int64Value(int64(intValue(escalationPolicy.GetRepetitions())))
+	model.StepStrategy = types.StringPointerValue(escalationPolicy.GetStepStrategy())
 
-	// Set notification priority policies
-	var policies []map[string]interface{}
-	if priorityPolicies := escalationPolicy.GetNotificationPriorityPolicies(); priorityPolicies != nil {
-		tflog.Debug(ctx, fmt.Sprintf("Found %d notification priority policies", len(priorityPolicies)), map[string]interface{}{
-			"count": len(priorityPolicies),
+	priorityPolicies := escalationPolicy.GetNotificationPriorityPolicies()
+	tflog.Debug(ctx, fmt.Sprintf("Found %d notification priority policies", len(priorityPolicies)), map[string]interface{}{
+		"count": len(priorityPolicies),
+	})
+	policies := []notificationPriorityPolicyModel{}
+	for _, policy := range priorityPolicies {
+		policies = append(policies, notificationPriorityPolicyModel{
+			Priority:    types.StringPointerValue(policy.GetNotificationPriority()),
+			Repetitions: types.Int64PointerValue(int64Pointer(policy.GetRepetitions())),
+			HandoffStep: handoffStepToModel(policy.GetHandoffStep()),
 		})
-		for _, policy := range priorityPolicies {
-			policyMap := map[string]interface{}{
-				"priority": *policy.GetNotificationPriority(),
-			}
-
-			// Set repetitions if available
-			if repetitions := policy.GetRepetitions(); repetitions != nil {
-				policyMap["repetitions"] = *repetitions
-			}
-
-			// Set handoff step if available
-			if handoffStep := policy.GetHandoffStep(); handoffStep != nil {
-				if target := handoffStep.GetTarget(); target != nil {
-					handoffStepMap := map[string]interface{}{
-						"target_type": *target.GetType(),
-						"target_id":   *target.GetID(),
-					}
-					policyMap["handoff_step"] = []map[string]interface{}{handoffStepMap}
-				}
-			}
-
-			policies = append(policies, policyMap)
-		}
-	} else {
-		tflog.Debug(ctx, "No notification priority policies found in API response", map[string]interface{}{})
 	}
-	// Always set notification_priority_policies, even if empty, to prevent "attribute not found" errors
-	tflog.Debug(ctx, fmt.Sprintf("Setting notification_priority_policies with %d policies", len(policies)), map[string]interface{}{
-		"policies": policies,
-	})
-	d.Set("notification_priority_policies", policies)
+	model.NotificationPriorityPolicies = policies
 
-	// Set the steps
-	var steps []map[string]interface{}
+	steps := []escalationPolicyStepModel{}
 	for _, step := range escalationPolicy.GetSteps() {
-		targets := []map[string]interface{}{}
+		targets := []escalationPolicyTargetModel{}
 		for _, target := range step.GetTargets() {
-			targetMap := map[string]interface{}{
-				"type": *target.GetType(),
-				"id":   *target.GetID(),
-			}
-
-			targets = append(targets, targetMap)
-		}
-
-		stepMap := map[string]interface{}{
-			"timeout": *step.GetTimeout(),
-			"targets": targets,
+			targets = append(targets, escalationPolicyTargetModel{
+				Type: types.StringPointerValue(target.GetType()),
+				ID:   types.StringPointerValue(target.GetID()),
+			})
 		}
 
-		if priorities := step.GetPriorities(); priorities != nil && len(priorities) > 0 {
-			stepMap["priorities"] = priorities
+		priorities := types.ListNull(types.StringType)
+		if len(step.GetPriorities()) > 0 {
+			var d diag.Diagnostics
+			priorities, d = types.ListValueFrom(ctx, types.StringType, step.GetPriorities())
+			diags.Append(d...)
 		}
 
-		steps = append(steps, stepMap)
+		steps = append(steps, escalationPolicyStepModel{
+			Timeout:    types.StringPointerValue(step.GetTimeout()),
+			Targets:    targets,
+			Priorities: priorities,
+		})
 	}
+	model.Steps = steps
+	model.HandoffStep = handoffStepToModel(escalationPolicy.GetHandoffStep())
+	model.keepUnset(ctx, prior)
 
-	d.Set("step", steps)
+	return true, diags
+}
 
-	// Set the handoff step
-	if handoffStep := escalationPolicy.GetHandoffStep(); handoffStep != nil {
-		if target := handoffStep.GetTarget(); target != nil {
-			handoffStepMap := map[string]interface{}{
-				"target_type": *target.GetType(),
-				"target_id":   *target.GetID(),
-			}
+// keepUnset sets the optional attributes that are null in prior back to null when the API
+// returned the value it uses when they aren't set, so leaving them out of the configuration
+// doesn't show a diff
+func (m *escalationPolicyResourceModel) keepUnset(ctx context.Context, prior escalationPolicyResourceModel) {
+	if prior.Description.IsNull() && m.Description.ValueString() == "" {
+		m.Description = types.StringNull()
+	}
+	if prior.Default.IsNull() && !m.Default.ValueBool() {
+		m.Default = types.BoolNull()
+	}
+	if stepStrategy := m.StepStrategy.ValueString(); prior.StepStrategy.IsNull() && (stepStrategy == "" || stepStrategy == "static") {
+		m.StepStrategy = types.StringNull()
+	}
 
-			d.Set("handoff_step", []map[string]interface{}{handoffStepMap})
+	for i := range m.NotificationPriorityPolicies {
+		policy := &m.NotificationPriorityPolicies[i]
+		priorNull := i >= len(prior.NotificationPriorityPolicies) || prior.NotificationPriorityPolicies[i].Repetitions.IsNull()
+		if priorNull && policy.Repetitions.ValueInt64() == 0 {
+			policy.Repetitions = types.Int64Null()
 		}
 	}
 
-	return diag.Diagnostics{}
+	// Steps of a dynamic policy that don't set their priorities get all priorities of
+	// notification_priority_policies, see updateSteps
+	defaultPriorities := m.defaultPriorities()
+	for i := range m.Steps {
+		step := &m.Steps[i]
+		if i < len(prior.Steps) && !prior.Steps[i].Priorities.IsNull() {
+			continue
+		}
+		if priorities := step.priorities(ctx); len(priorities) == 0 || slices.Equal(priorities, defaultPriorities) {
+			step.Priorities = types.ListNull(types.StringType)
+		}
+	}
 }
 
 // creates an escalation policy for a team using the firehydrant api client
-func createResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*firehydrant.APIClient)
-
-	// Create the escalation policy
-	teamID := d.Get("team_id").(string)
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	defaultVal := d.Get("default").(bool)
-	repetitions := d.Get("repetitions").(int)
+func (r *escalationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan escalationPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
+	teamID := plan.TeamID.ValueString()
 	createReq := components.CreateTeamEscalationPolicy{
-		Name:        name,
-		Description: &description,
-		Default:     &defaultVal,
-		Repetitions: &repetitions,
-		Steps:       getStepsFromResourceData(d),
-		HandoffStep: getHandoffStepFromResourceData(d),
+		Name:        plan.Name.ValueString(),
+		Description: ptr.Of(plan.Description.ValueString()),
+		Default:     ptr.Of(plan.Default.ValueBool()),
+		Repetitions: ptr.Of(int(plan.Repetitions.ValueInt64())),
+		Steps:       plan.createSteps(ctx),
+		HandoffStep: plan.createHandoffStep(),
 	}
 
 	// Handle step strategy
-	if stepStrategy := d.Get("step_strategy").(string); stepStrategy != "" {
+	if stepStrategy := plan.StepStrategy.ValueString(); stepStrategy != "" {
 		createReq.StepStrategy = &stepStrategy
 	}
 
 	// Handle notification priority policies
-	if priorityPolicies := d.Get("notification_priority_policies").([]interface{}); len(priorityPolicies) > 0 {
-		createReq.PrioritizedSettings = getNotificationPriorityPoliciesFromResourceData(d)
+	if len(plan.NotificationPriorityPolicies) > 0 {
+		createReq.PrioritizedSettings = plan.createPrioritizedSettings()
 	}
 
 	tflog.Debug(ctx, "Creating escalation policy", map[string]interface{}{
@@ -298,204 +443,279 @@ func createResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.Re
 		"request": createReq,
 	})
 
-	escalationPolicy, err := client.Sdk.Signals.CreateTeamEscalationPolicy(ctx, teamID, createReq)
+	escalationPolicy, err := r.client.Sdk.Signals.CreateTeamEscalationPolicy(ctx, teamID, createReq)
 	if err != nil {
-		return diag.Errorf("Error creating escalation policy %s: %v", d.Id(), err)
+		resp.Diagnostics.AddError("Error creating escalation policy", err.Error())
+		return
 	}
 
 	// Set the ID of the escalation policy
-	d.SetId(*escalationPolicy.GetID())
-	return readResourceFireHydrantEscalationPolicy(ctx, d, m)
+	plan.ID = types.StringPointerValue(escalationPolicy.GetID())
+	r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
 }
 
-func updateResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*firehydrant.APIClient)
+func (r *escalationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state escalationPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// State written by an earlier version of the provider can differ from the plan only in
+	// how attributes that aren't set are stored, which isn't worth updating the policy for
+	updateReq := plan.updateRequest(ctx)
+	if sameUpdateRequest(updateReq, state.updateRequest(ctx)) {
+		r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	id := plan.ID.ValueString()
+	teamID := plan.TeamID.ValueString()
+	tflog.Debug(ctx, "Updating escalation policy", map[string]interface{}{
+		"team_id": teamID,
+		"request": spew.Sdump(updateReq),
+	})
 
-	// Update the escalation policy
-	teamID := d.Get("team_id").(string)
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	defaultVal := d.Get("default").(bool)
-	repetitions := d.Get("repetitions").(int)
+	_, err := r.client.Sdk.Signals.UpdateTeamEscalationPolicy(ctx, teamID, id, updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating escalation policy %s", id), err.Error())
+		return
+	}
 
+	r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (m escalationPolicyResourceModel) updateRequest(ctx context.Context) components.UpdateTeamEscalationPolicy {
 	updateReq := components.UpdateTeamEscalationPolicy{
-		Name:        &name,
-		Description: &description,
-		Default:     &defaultVal,
-		Repetitions: &repetitions,
-		Steps:       getStepsFromResourceDataUpdateSDK(d),
-		HandoffStep: getHandoffStepFromResourceDataUpdateSDK(d),
+		Name:        ptr.Of(m.Name.ValueString()),
+		Description: ptr.Of(m.Description.ValueString()),
+		Default:     ptr.Of(m.Default.ValueBool()),
+		Repetitions: ptr.Of(int(m.Repetitions.ValueInt64())),
+		Steps:       m.updateSteps(ctx),
+		HandoffStep: m.updateHandoffStep(),
 	}
 
 	// Handle step strategy
-	if stepStrategy := d.Get("step_strategy").(string); stepStrategy != "" {
+	if stepStrategy := m.StepStrategy.ValueString(); stepStrategy != "" {
 		updateReq.StepStrategy = &stepStrategy
 	}
 
 	// Handle notification priority policies
-	if priorityPolicies := d.Get("notification_priority_policies").([]interface{}); len(priorityPolicies) > 0 {
-		updateReq.PrioritizedSettings = getNotificationPriorityPoliciesFromResourceDataUpdate(d)
+	if len(m.NotificationPriorityPolicies) > 0 {
+		updateReq.PrioritizedSettings = m.updatePrioritizedSettings()
 	}
 
-	tflog.Debug(ctx, "Updating escalation policy", map[string]interface{}{
-		"team_id": teamID,
-		"request": spew.Sdump(updateReq),
-	})
+	return updateReq
+}
 
-	_, err := client.Sdk.Signals.UpdateTeamEscalationPolicy(ctx, teamID, d.Id(), updateReq)
-	if err != nil {
-		return diag.Errorf("Error updating escalation policy %s: %v", d.Id(), err)
+// sameUpdateRequest reports whether two update requests leave the policy the same. A request
+// without a step strategy keeps the API's default, static.
+func sameUpdateRequest(a, b components.UpdateTeamEscalationPolicy) bool {
+	if a.StepStrategy == nil {
+		a.StepStrategy = ptr.Of("static")
 	}
+	if b.StepStrategy == nil {
+		b.StepStrategy = ptr.Of("static")
+	}
+	return reflect.DeepEqual(a, b)
+}
 
-	return readResourceFireHydrantEscalationPolicy(ctx, d, m)
+// readAfterWrite refreshes the model after it was created or updated and saves it to state
+func (r *escalationPolicyResource) readAfterWrite(ctx context.Context, model *escalationPolicyResourceModel, state stateSetter, respDiags *diag.Diagnostics) {
+	found, diags := r.read(ctx, model)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+	if !found {
+		respDiags.AddError("Escalation policy disappeared", fmt.Sprintf("Escalation policy %s was not found after it was saved", model.ID.ValueString()))
+		return
+	}
+	respDiags.Append(state.Set(ctx, model)...)
 }
 
-func deleteResourceFireHydrantEscalationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*firehydrant.APIClient)
+func (r *escalationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state escalationPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	// Delete the escalation policy
-	teamID := d.Get("team_id").(string)
+	id := state.ID.ValueString()
+	teamID := state.TeamID.ValueString()
 	tflog.Debug(ctx, "Deleting escalation policy", map[string]interface{}{
 		"team_id": teamID,
-		"id":      d.Id(),
+		"id":      id,
 	})
 
-	err := client.Sdk.Signals.DeleteTeamEscalationPolicy(ctx, teamID, d.Id())
-	if err != nil {
-		return diag.Errorf("Error deleting escalation policy %s: %v", d.Id(), err)
+	err := r.client.Sdk.Signals.DeleteTeamEscalationPolicy(ctx, teamID, id)
+	if err != nil && !firehydrant.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting escalation policy %s", id), err.Error())
 	}
-
-	return nil
 }
 
-func getHandoffStepFromResourceData(d *schema.ResourceData) *components.CreateTeamEscalationPolicyHandoffStep {
-	if v, ok := d.GetOk("handoff_step"); ok {
-		handoffStepList := v.([]interface{})
+func (r *escalationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, id, err := resourceFireHydrantEscalationPolicyParseId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	id, err = escalationPolicyImportLookup(teamID).resolve(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing escalation policy", err.Error())
+		return
+	}
 
-		if len(handoffStepList) > 0 {
-			handoffStepMap := handoffStepList[0].(map[string]interface{})
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
 
-			handoffStep := &components.CreateTeamEscalationPolicyHandoffStep{
-				TargetType: components.CreateTeamEscalationPolicyTargetType(handoffStepMap["target_type"].(string)),
-				TargetID:   handoffStepMap["target_id"].(string),
-			}
+func resourceFireHydrantEscalationPolicyParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
 
-			return handoffStep
-		}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected Team_ID:Escalation_Policy_ID", id)
 	}
 
-	return nil
+	return parts[0], parts[1], nil
 }
 
-func getHandoffStepFromResourceDataUpdateSDK(d *schema.ResourceData) *components.UpdateTeamEscalationPolicyHandoffStep {
-	if v, ok := d.GetOk("handoff_step"); ok {
-		handoffStepList := v.([]interface{})
-
-		if len(handoffStepList) > 0 {
-			handoffStepMap := handoffStepList[0].(map[string]interface{})
+func handoffStepToModel(handoffStep *components.NullableSignalsAPIEscalationPolicyHandoffStepEntity) []escalationPolicyHandoffModel {
+	if handoffStep == nil || handoffStep.GetTarget() == nil {
+		return []escalationPolicyHandoffModel{}
+	}
+	target := handoffStep.GetTarget()
+	return []escalationPolicyHandoffModel{{
+		TargetType: types.StringPointerValue(target.GetType()),
+		TargetID:   types.StringPointerValue(target.GetID()),
+	}}
+}
 
-			handoffStep := &components.UpdateTeamEscalationPolicyHandoffStep{
-				TargetType: components.UpdateTeamEscalationPolicyTargetType(handoffStepMap["target_type"].(string)),
-				TargetID:   handoffStepMap["target_id"].(string),
-			}
+func (m escalationPolicyResourceModel) createHandoffStep() *components.CreateTeamEscalationPolicyHandoffStep {
+	if len(m.HandoffStep) == 0 {
+		return nil
+	}
+	return &components.CreateTeamEscalationPolicyHandoffStep{
+		TargetType: components.CreateTeamEscalationPolicyTargetType(m.HandoffStep[0].TargetType.ValueString()),
+		TargetID:   m.HandoffStep[0].TargetID.ValueString(),
+	}
+}
 
-			return handoffStep
-		}
+func (m escalationPolicyResourceModel) updateHandoffStep() *components.UpdateTeamEscalationPolicyHandoffStep {
+	if len(m.HandoffStep) == 0 {
+		return nil
 	}
+	return &components.UpdateTeamEscalationPolicyHandoffStep{
+		TargetType: components.UpdateTeamEscalationPolicyTargetType(m.HandoffStep[0].TargetType.ValueString()),
+		TargetID:   m.HandoffStep[0].TargetID.ValueString(),
+	}
+}
 
-	return nil
+// priorities returns the priorities configured on the step, if any
+func (s escalationPolicyStepModel) priorities(ctx context.Context) []string {
+	var priorities []string
+	if !s.Priorities.IsNull() && !s.Priorities.IsUnknown() {
+		s.Priorities.ElementsAs(ctx, &priorities, false)
+	}
+	return priorities
 }
 
-func getStepsFromResourceData(d *schema.ResourceData) []components.CreateTeamEscalationPolicyStep {
+func (m escalationPolicyResourceModel) createSteps(ctx context.Context) []components.CreateTeamEscalationPolicyStep {
 	var steps []components.CreateTeamEscalationPolicyStep
-
-	if v, ok := d.GetOk("step"); ok {
-		stepList := v.([]interface{})
-
-		for _, stepItem := range stepList {
-			stepMap := stepItem.(map[string]interface{})
-			targets := stepMap["targets"].([]interface{})
-
-			var stepTargets []components.CreateTeamEscalationPolicyTarget
-			for _, targetItem := range targets {
-				targetMap := targetItem.(map[string]interface{})
-				target := components.CreateTeamEscalationPolicyTarget{
-					Type: components.CreateTeamEscalationPolicyType(targetMap["type"].(string)),
-					ID:   targetMap["id"].(string),
-				}
-
-				stepTargets = append(stepTargets, target)
-			}
-
-			step := components.CreateTeamEscalationPolicyStep{
-				Timeout: stepMap["timeout"].(string),
-				Targets: stepTargets,
-			}
-
-			// Only set priorities if explicitly defined on the step
-			if stepPriorities, ok := stepMap["priorities"].([]interface{}); ok && len(stepPriorities) > 0 {
-				// Use step-specific priorities
-				var priorities []string
-				for _, p := range stepPriorities {
-					priorities = append(priorities, p.(string))
-				}
-				step.Priorities = priorities
-			}
-
-			steps = append(steps, step)
+	for _, s := range m.Steps {
+		var stepTargets []components.CreateTeamEscalationPolicyTarget
+		for _, target := range s.Targets {
+			stepTargets = append(stepTargets, components.CreateTeamEscalationPolicyTarget{
+				Type: components.CreateTeamEscalationPolicyType(target.Type.ValueString()),
+				ID:   target.ID.ValueString(),
+			})
 		}
+
+		// Only set priorities if explicitly defined on the step
+		steps = append(steps, components.CreateTeamEscalationPolicyStep{
+			Timeout:    s.Timeout.ValueString(),
+			Targets:    stepTargets,
+			Priorities: s.priorities(ctx),
+		})
 	}
 
 	return steps
 }
 
-// getPrioritiesFromNotificationPriorityPolicies extracts the priority values from notification_priority_policies
-func getPrioritiesFromNotificationPriorityPolicies(d *schema.ResourceData) []string {
+// defaultPriorities returns the priorities of notification_priority_policies, which steps
+// of a dynamic policy that don't set their priorities are updated with
+func (m escalationPolicyResourceModel) defaultPriorities() []string {
 	var priorities []string
-	priorityPolicies := d.Get("notification_priority_policies").([]interface{})
-
-	for _, policyItem := range priorityPolicies {
-		policyMap := policyItem.(map[string]interface{})
-		if priority, ok := policyMap["priority"].(string); ok {
-			priorities = append(priorities, priority)
+	if m.StepStrategy.ValueString() == "dynamic_by_priority" {
+		for _, policy := range m.NotificationPriorityPolicies {
+			priorities = append(priorities, policy.Priority.ValueString())
 		}
 	}
-
 	return priorities
 }
 
-func getNotificationPriorityPoliciesFromResourceDataUpdate(d *schema.ResourceData) *components.UpdateTeamEscalationPolicyPrioritizedSettings {
-	priorityPolicies := d.Get("notification_priority_policies").([]interface{})
-	if len(priorityPolicies) == 0 {
-		return nil
+func (m escalationPolicyResourceModel) updateSteps(ctx context.Context) []components.UpdateTeamEscalationPolicyStep {
+	// Get all priorities from notification_priority_policies as fallback for updates
+	// This is required to preserve notification_priority_policies during updates
+	defaultPriorities := m.defaultPriorities()
+
+	var steps []components.UpdateTeamEscalationPolicyStep
+	for _, s := range m.Steps {
+		var stepTargets []components.UpdateTeamEscalationPolicyTarget
+		for _, target := range s.Targets {
+			stepTargets = append(stepTargets, components.UpdateTeamEscalationPolicyTarget{
+				Type: components.UpdateTeamEscalationPolicyType(target.Type.ValueString()),
+				ID:   target.ID.ValueString(),
+			})
+		}
+
+		step := components.UpdateTeamEscalationPolicyStep{
+			Timeout:    s.Timeout.ValueString(),
+			Targets:    stepTargets,
+			Priorities: s.priorities(ctx),
+		}
+		if len(step.Priorities) == 0 && len(defaultPriorities) > 0 {
+			// For updates, apply all priorities from notification_priority_policies
+			// This is required to preserve notification_priority_policies
+			step.Priorities = defaultPriorities
+		}
+
+		steps = append(steps, step)
 	}
 
-	settings := &components.UpdateTeamEscalationPolicyPrioritizedSettings{}
+	return steps
+}
 
-	for _, policyItem := range priorityPolicies {
-		policyMap := policyItem.(map[string]interface{})
-		priority := policyMap["priority"].(string)
+func (m escalationPolicyResourceModel) updatePrioritizedSettings() *components.UpdateTeamEscalationPolicyPrioritizedSettings {
+	settings := &components.UpdateTeamEscalationPolicyPrioritizedSettings{}
 
-		// Get repetitions if specified
-		var repetitions *int
-		if reps, ok := policyMap["repetitions"]; ok && reps != nil {
-			if repsInt, ok := reps.(int); ok {
-				repetitions = &repsInt
-			}
-		}
+	for _, policy := range m.NotificationPriorityPolicies {
+		repetitions := intPointer(policy.Repetitions)
 
 		// Set the appropriate priority policy
-		switch firehydrant.NotificationPriority(priority) {
+		switch firehydrant.NotificationPriority(policy.Priority.ValueString()) {
 		case firehydrant.NotificationPriorityHigh:
 			var handoffStep *components.UpdateTeamEscalationPolicyHighHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.UpdateTeamEscalationPolicyHighHandoffStep{
-						TargetType: components.UpdateTeamEscalationPolicyHighTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.UpdateTeamEscalationPolicyHighHandoffStep{
+					TargetType: components.UpdateTeamEscalationPolicyHighTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.High = &components.UpdateTeamEscalationPolicyHigh{
@@ -504,14 +724,10 @@ func getNotificationPriorityPoliciesFromResourceDataUpdate(d *schema.ResourceDat
 			}
 		case firehydrant.NotificationPriorityMedium:
 			var handoffStep *components.UpdateTeamEscalationPolicyMediumHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.UpdateTeamEscalationPolicyMediumHandoffStep{
-						TargetType: components.UpdateTeamEscalationPolicyMediumTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.UpdateTeamEscalationPolicyMediumHandoffStep{
+					TargetType: components.UpdateTeamEscalationPolicyMediumTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.Medium = &components.UpdateTeamEscalationPolicyMedium{
@@ -520,14 +736,10 @@ func getNotificationPriorityPoliciesFromResourceDataUpdate(d *schema.ResourceDat
 			}
 		case firehydrant.NotificationPriorityLow:
 			var handoffStep *components.UpdateTeamEscalationPolicyLowHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.UpdateTeamEscalationPolicyLowHandoffStep{
-						TargetType: components.UpdateTeamEscalationPolicyLowTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.UpdateTeamEscalationPolicyLowHandoffStep{
+					TargetType: components.UpdateTeamEscalationPolicyLowTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.Low = &components.UpdateTeamEscalationPolicyLow{
@@ -540,42 +752,23 @@ func getNotificationPriorityPoliciesFromResourceDataUpdate(d *schema.ResourceDat
 	return settings
 }
 
-func getNotificationPriorityPoliciesFromResourceData(d *schema.ResourceData) *components.CreateTeamEscalationPolicyPrioritizedSettings {
-	priorityPolicies := d.Get("notification_priority_policies").([]interface{})
-	if len(priorityPolicies) == 0 {
-		return nil
-	}
-
+func (m escalationPolicyResourceModel) createPrioritizedSettings() *components.CreateTeamEscalationPolicyPrioritizedSettings {
 	settings := &components.CreateTeamEscalationPolicyPrioritizedSettings{}
 
-	for _, policyItem := range priorityPolicies {
-		policyMap := policyItem.(map[string]interface{})
-		priority := policyMap["priority"].(string)
-
-		// For dynamic escalation policies, the steps are defined at the main policy level
-		// Priority-specific policies mainly handle repetitions and handoff steps
-		// The steps from the policy are used for all priorities unless overridden
-
-		// Get repetitions if specified
-		var repetitions *int
-		if reps, ok := policyMap["repetitions"]; ok && reps != nil {
-			if repsInt, ok := reps.(int); ok {
-				repetitions = &repsInt
-			}
-		}
+	// For dynamic escalation policies, the steps are defined at the main policy level
+	// Priority-specific policies mainly handle repetitions and handoff steps
+	// The steps from the policy are used for all priorities unless overridden
+	for _, policy := range m.NotificationPriorityPolicies {
+		repetitions := intPointer(policy.Repetitions)
 
 		// Set the appropriate priority policy
-		switch firehydrant.NotificationPriority(priority) {
+		switch firehydrant.NotificationPriority(policy.Priority.ValueString()) {
 		case firehydrant.NotificationPriorityHigh:
 			var handoffStep *components.CreateTeamEscalationPolicyHighHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.CreateTeamEscalationPolicyHighHandoffStep{
-						TargetType: components.CreateTeamEscalationPolicyHighTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.CreateTeamEscalationPolicyHighHandoffStep{
+					TargetType: components.CreateTeamEscalationPolicyHighTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.High = &components.CreateTeamEscalationPolicyHigh{
@@ -584,14 +777,10 @@ func getNotificationPriorityPoliciesFromResourceData(d *schema.ResourceData) *co
 			}
 		case firehydrant.NotificationPriorityMedium:
 			var handoffStep *components.CreateTeamEscalationPolicyMediumHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.CreateTeamEscalationPolicyMediumHandoffStep{
-						TargetType: components.CreateTeamEscalationPolicyMediumTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.CreateTeamEscalationPolicyMediumHandoffStep{
+					TargetType: components.CreateTeamEscalationPolicyMediumTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.Medium = &components.CreateTeamEscalationPolicyMedium{
@@ -600,14 +789,10 @@ func getNotificationPriorityPoliciesFromResourceData(d *schema.ResourceData) *co
 			}
 		case firehydrant.NotificationPriorityLow:
 			var handoffStep *components.CreateTeamEscalationPolicyLowHandoffStep
-			if handoff, ok := policyMap["handoff_step"]; ok && handoff != nil {
-				handoffList := handoff.([]interface{})
-				if len(handoffList) > 0 {
-					handoffMap := handoffList[0].(map[string]interface{})
-					handoffStep = &components.CreateTeamEscalationPolicyLowHandoffStep{
-						TargetType: components.CreateTeamEscalationPolicyLowTargetType(handoffMap["target_type"].(string)),
-						TargetID:   handoffMap["target_id"].(string),
-					}
+			if len(policy.HandoffStep) > 0 {
+				handoffStep = &components.CreateTeamEscalationPolicyLowHandoffStep{
+					TargetType: components.CreateTeamEscalationPolicyLowTargetType(policy.HandoffStep[0].TargetType.ValueString()),
+					TargetID:   policy.HandoffStep[0].TargetID.ValueString(),
 				}
 			}
 			settings.Low = &components.CreateTeamEscalationPolicyLow{
@@ -620,57 +805,22 @@ func getNotificationPriorityPoliciesFromResourceData(d *schema.ResourceData) *co
 	return settings
 }
 
-func getStepsFromResourceDataUpdateSDK(d *schema.ResourceData) []components.UpdateTeamEscalationPolicyStep {
-	var steps []components.UpdateTeamEscalationPolicyStep
-
-	if v, ok := d.GetOk("step"); ok {
-		stepList := v.([]interface{})
-		stepStrategy := d.Get("step_strategy").(string)
-
-		// Get all priorities from notification_priority_policies as fallback for updates
-		// This is required to preserve notification_priority_policies during updates
-		var defaultPriorities []string
-		if stepStrategy == "dynamic_by_priority" {
-			defaultPriorities = getPrioritiesFromNotificationPriorityPolicies(d)
-		}
-
-		for _, stepItem := range stepList {
-			stepMap := stepItem.(map[string]interface{})
-			targets := stepMap["targets"].([]interface{})
-
-			var stepTargets []components.UpdateTeamEscalationPolicyTarget
-			for _, targetItem := range targets {
-				targetMap := targetItem.(map[string]interface{})
-				target := components.UpdateTeamEscalationPolicyTarget{
-					Type: components.UpdateTeamEscalationPolicyType(targetMap["type"].(string)),
-					ID:   targetMap["id"].(string),
-				}
-
-				stepTargets = append(stepTargets, target)
-			}
-
-			step := components.UpdateTeamEscalationPolicyStep{
-				Timeout: stepMap["timeout"].(string),
-				Targets: stepTargets,
-			}
-
-			// Check if step has its own priorities
-			if stepPriorities, ok := stepMap["priorities"].([]interface{}); ok && len(stepPriorities) > 0 {
-				// Use step-specific priorities
-				var priorities []string
-				for _, p := range stepPriorities {
-					priorities = append(priorities, p.(string))
-				}
-				step.Priorities = priorities
-			} else if stepStrategy == "dynamic_by_priority" && len(defaultPriorities) > 0 {
-				// For updates, apply all priorities from notification_priority_policies
-				// This is required to preserve notification_priority_policies
-				step.Priorities = defaultPriorities
-			}
-
-			steps = append(steps, step)
-		}
+// escalationPolicyImportLookup resolves Team_ID:name=<name> imports within the given team
+func escalationPolicyImportLookup(teamID string) importLookup {
+	return importLookup{
+		resourceName: "escalation policy",
+		fields:       []string{"name"},
+		list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+			request := operations.ListTeamEscalationPoliciesRequest{TeamID: teamID, Query: &value, PerPage: ptr.Of(importLookupPerPage)}
+			return listImportCandidates(ctx, client, &request,
+				func(request *operations.ListTeamEscalationPoliciesRequest, page *int) { request.Page = page },
+				func(ctx context.Context, request *operations.ListTeamEscalationPoliciesRequest) (pagination.PaginateResponse[components.SignalsAPIEscalationPolicyEntity], error) {
+					return client.Sdk.Signals.ListTeamEscalationPolicies(ctx, request.TeamID, request.Query, request.Page, request.PerPage)
+				},
+				func(policy components.SignalsAPIEscalationPolicyEntity) importCandidate {
+					return importCandidate{ID: stringValue(policy.GetID()), Name: stringValue(policy.GetName())}
+				},
+			)
+		},
 	}
-
-	return steps
 }
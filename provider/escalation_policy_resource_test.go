@@ -7,8 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -33,15 +38,15 @@ func TestAccEscalationPolicyResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckEscalationPolicyResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckEscalationPolicyResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEscalationPolicyConfig_basic(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_escalation_policy.test_escalation_policy", "id"),
-					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("test-escalation-policy-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("tf-acc-escalation-policy-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step_strategy", "static"),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step.0.timeout", "PT1M"),
@@ -50,6 +55,20 @@ func TestAccEscalationPolicyResource_basic(t *testing.T) {
 					sleepBeforeDestroy(escalationPolicySettleDelay),
 				),
 			},
+			{
+				ResourceName: "firehydrant_escalation_policy.test_escalation_policy",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["firehydrant_escalation_policy.test_escalation_policy"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "firehydrant_escalation_policy.test_escalation_policy")
+					}
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["id"]), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+				// A static step strategy is imported as not set, the API's default
+				ImportStateVerifyIgnore: []string{"step_strategy"},
+			},
 		},
 	})
 }
@@ -61,15 +80,15 @@ func TestAccEscalationPolicyResource_dynamicWithPriorityPolicies(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckEscalationPolicyResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckEscalationPolicyResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEscalationPolicyConfig_dynamicPriority(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_escalation_policy.test_escalation_policy", "id"),
-					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("test-escalation-policy-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("tf-acc-escalation-policy-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step_strategy", "dynamic_by_priority"),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "repetitions", "1"),
@@ -85,7 +104,7 @@ func TestAccEscalationPolicyResource_dynamicWithPriorityPolicies(t *testing.T) {
 				Config: testAccEscalationPolicyConfig_dynamicPriorityUpdated(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_escalation_policy.test_escalation_policy", "id"),
-					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("test-escalation-policy-updated-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("tf-acc-escalation-policy-updated-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "description", fmt.Sprintf("test-description-updated-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step_strategy", "dynamic_by_priority"),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "repetitions", "1"),
@@ -108,15 +127,15 @@ func TestAccEscalationPolicyResource_dynamicWithHandoffSteps(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckEscalationPolicyResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckEscalationPolicyResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEscalationPolicyConfig_dynamicWithHandoffSteps(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_escalation_policy.test_escalation_policy", "id"),
-					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("test-escalation-policy-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", fmt.Sprintf("tf-acc-escalation-policy-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step_strategy", "dynamic_by_priority"),
 					// Test notification priority policies with handoff steps
 					resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.0.priority", "HIGH"),
@@ -130,11 +149,72 @@ func TestAccEscalationPolicyResource_dynamicWithHandoffSteps(t *testing.T) {
 	})
 }
 
+func TestOfflineEscalationPolicyResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+	scheduleID := server.Seed("/v1/teams/"+teamID+"/on_call_schedules", map[string]interface{}{"name": "Primary"})[0]["id"].(string)
+
+	testFakeAPICase(t, server, "firehydrant_escalation_policy", "/v1/teams/{team_id}/escalation_policies", []resource.TestStep{
+		{
+			Config: testAccEscalationPolicyConfig_basic("offline", teamID, scheduleID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_escalation_policy.test_escalation_policy", "id"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step.0.targets.0.id", scheduleID),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "handoff_step.0.target_id", teamID),
+			),
+		},
+		{
+			Config: testAccEscalationPolicyConfig_basic("renamed", teamID, scheduleID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "name", "tf-acc-escalation-policy-renamed"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "description", "test-description-renamed"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_escalation_policy.test_escalation_policy",
+			ImportStateIdFunc: testFakeAPIImportStateIDForTeam("firehydrant_escalation_policy.test_escalation_policy"),
+			ImportState:       true,
+			ImportStateVerify: true,
+			// A static step strategy is imported as not set, the API's default
+			ImportStateVerifyIgnore: []string{"step_strategy"},
+		},
+	})
+}
+
+func TestOfflineEscalationPolicyResource_dynamicWithPriorityPolicies(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+	scheduleID := server.Seed("/v1/teams/"+teamID+"/on_call_schedules", map[string]interface{}{"name": "Primary"})[0]["id"].(string)
+
+	testFakeAPICase(t, server, "firehydrant_escalation_policy", "/v1/teams/{team_id}/escalation_policies", []resource.TestStep{
+		{
+			Config: testAccEscalationPolicyConfig_dynamicPriority("offline", teamID, scheduleID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step_strategy", "dynamic_by_priority"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "step.1.priorities.0", "LOW"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.0.priority", "HIGH"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.0.repetitions", "2"),
+			),
+		},
+		{
+			Config: testAccEscalationPolicyConfig_dynamicPriorityUpdated("offline", teamID, scheduleID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.0.priority", "HIGH"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.0.repetitions", "3"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.1.priority", "MEDIUM"),
+				resource.TestCheckResourceAttr("firehydrant_escalation_policy.test_escalation_policy", "notification_priority_policies.1.repetitions", "1"),
+			),
+		},
+	})
+}
+
 func testAccEscalationPolicyConfig_basic(rName, sharedTeamID, sharedScheduleID string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_escalation_policy" "test_escalation_policy" {
 		team_id = "%s"
-		name = "test-escalation-policy-%s"
+		name = "tf-acc-escalation-policy-%s"
 		description = "test-description-%s"
 		repetitions = 1
 		step_strategy = "static"
@@ -160,7 +240,7 @@ func testAccEscalationPolicyConfig_dynamicPriority(rName, sharedTeamID, sharedSc
 	return fmt.Sprintf(`
 	resource "firehydrant_escalation_policy" "test_escalation_policy" {
 		team_id = "%s"
-		name = "test-escalation-policy-%s"
+		name = "tf-acc-escalation-policy-%s"
 		description = "test-description-%s"
 		repetitions = 1
 		step_strategy = "dynamic_by_priority"
@@ -202,7 +282,7 @@ func testAccEscalationPolicyConfig_dynamicPriorityUpdated(rName, sharedTeamID, s
 	return fmt.Sprintf(`
 	resource "firehydrant_escalation_policy" "test_escalation_policy" {
 		team_id = "%s"
-		name = "test-escalation-policy-updated-%s"
+		name = "tf-acc-escalation-policy-updated-%s"
 		description = "test-description-updated-%s"
 		repetitions = 1
 		step_strategy = "dynamic_by_priority"
@@ -234,7 +314,7 @@ func testAccEscalationPolicyConfig_dynamicWithHandoffSteps(rName, sharedTeamID,
 	return fmt.Sprintf(`
 	resource "firehydrant_escalation_policy" "test_escalation_policy" {
 		team_id = "%s"
-		name = "test-escalation-policy-%s"
+		name = "tf-acc-escalation-policy-%s"
 		description = "test-description-%s"
 		repetitions = 1
 		step_strategy = "dynamic_by_priority"
@@ -292,3 +372,329 @@ func testAccCheckEscalationPolicyResourceDestroy() resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// testSDKEscalationPolicyProviderFactories serves firehydrant_escalation_policy the way the
+// plugin SDK resource did before it moved to the plugin framework, so tests can write state
+// with it. Creating a policy adopts the one with policyID, which tests seed in the fake API.
+func testSDKEscalationPolicyProviderFactories(policyID string) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"firehydrant": func() (tfprotov5.ProviderServer, error) {
+			provider := Provider()
+			provider.ResourcesMap["firehydrant_escalation_policy"] = testSDKEscalationPolicyResource(policyID)
+			return provider.GRPCProvider(), nil
+		},
+	}
+}
+
+func testSDKEscalationPolicyResource(policyID string) *schema.Resource {
+	handoffStep := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_type": {Type: schema.TypeString, Required: true},
+			"target_id":   {Type: schema.TypeString, Required: true},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.SetId(policyID)
+			return testSDKEscalationPolicyRead(ctx, d, m)
+		},
+		ReadContext: testSDKEscalationPolicyRead,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.Errorf("updating escalation policies isn't supported")
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"default":     {Type: schema.TypeBool, Optional: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"team_id":     {Type: schema.TypeString, Required: true, ForceNew: true},
+			"repetitions": {Type: schema.TypeInt, Required: true},
+			"step": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {Type: schema.TypeString, Required: true},
+						"targets": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {Type: schema.TypeString, Required: true},
+									"id":   {Type: schema.TypeString, Required: true},
+								},
+							},
+						},
+						"priorities": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					},
+				},
+			},
+			"handoff_step":  {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: handoffStep},
+			"step_strategy": {Type: schema.TypeString, Optional: true},
+			"notification_priority_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority":     {Type: schema.TypeString, Required: true},
+						"repetitions":  {Type: schema.TypeInt, Optional: true},
+						"handoff_step": {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: handoffStep},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testSDKEscalationPolicyRead sets what the plugin SDK resource read from the API
+func testSDKEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*firehydrant.APIClient)
+	escalationPolicy, err := client.Sdk.Signals.GetTeamEscalationPolicy(ctx, d.Get("team_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", *escalationPolicy.GetName())
+	d.Set("description", *escalationPolicy.GetDescription())
+	d.Set("default", *escalationPolicy.GetDefault())
+	d.Set("repetitions", *escalationPolicy.GetRepetitions())
+	if stepStrategy := escalationPolicy.GetStepStrategy(); stepStrategy != nil {
+		d.Set("step_strategy", *stepStrategy)
+	}
+
+	var policies []map[string]interface{}
+	for _, policy := range escalationPolicy.GetNotificationPriorityPolicies() {
+		policyMap := map[string]interface{}{
+			"priority": *policy.GetNotificationPriority(),
+		}
+		if repetitions := policy.GetRepetitions(); repetitions != nil {
+			policyMap["repetitions"] = *repetitions
+		}
+		if handoffStep := policy.GetHandoffStep(); handoffStep != nil && handoffStep.GetTarget() != nil {
+			policyMap["handoff_step"] = []map[string]interface{}{{
+				"target_type": *handoffStep.GetTarget().GetType(),
+				"target_id":   *handoffStep.GetTarget().GetID(),
+			}}
+		}
+		policies = append(policies, policyMap)
+	}
+	d.Set("notification_priority_policies", policies)
+
+	var steps []map[string]interface{}
+	for _, step := range escalationPolicy.GetSteps() {
+		targets := []map[string]interface{}{}
+		for _, target := range step.GetTargets() {
+			targets = append(targets, map[string]interface{}{
+				"type": *target.GetType(),
+				"id":   *target.GetID(),
+			})
+		}
+		stepMap := map[string]interface{}{
+			"timeout": *step.GetTimeout(),
+			"targets": targets,
+		}
+		if priorities := step.GetPriorities(); len(priorities) > 0 {
+			stepMap["priorities"] = priorities
+		}
+		steps = append(steps, stepMap)
+	}
+	d.Set("step", steps)
+
+	if handoffStep := escalationPolicy.GetHandoffStep(); handoffStep != nil && handoffStep.GetTarget() != nil {
+		d.Set("handoff_step", []map[string]interface{}{{
+			"target_type": *handoffStep.GetTarget().GetType(),
+			"target_id":   *handoffStep.GetTarget().GetID(),
+		}})
+	}
+
+	return nil
+}
+
+func TestOfflineEscalationPolicyResource_upgradeFromSDK(t *testing.T) {
+	cases := map[string]struct {
+		// policy is the policy as the API returns it, in the form of a create request
+		policy func(scheduleID, teamID string) map[string]interface{}
+		config func(scheduleID, teamID string) string
+	}{
+		"static": {
+			policy: func(scheduleID, teamID string) map[string]interface{} {
+				return map[string]interface{}{
+					"name":         "tf-acc-escalation-policy-upgrade",
+					"repetitions":  1,
+					"steps":        []interface{}{map[string]interface{}{"timeout": "PT1M", "targets": []interface{}{map[string]interface{}{"type": "OnCallSchedule", "id": scheduleID}}}},
+					"handoff_step": map[string]interface{}{"target_type": "Team", "target_id": teamID},
+				}
+			},
+			config: func(scheduleID, teamID string) string {
+				return fmt.Sprintf(`
+resource "firehydrant_escalation_policy" "test_escalation_policy" {
+  team_id     = %[2]q
+  name        = "tf-acc-escalation-policy-upgrade"
+  repetitions = 1
+
+  step {
+    timeout = "PT1M"
+    targets {
+      type = "OnCallSchedule"
+      id   = %[1]q
+    }
+  }
+
+  handoff_step {
+    target_type = "Team"
+    target_id   = %[2]q
+  }
+}
+`, scheduleID, teamID)
+			},
+		},
+		"dynamic": {
+			// Steps that don't set their priorities were updated with all priorities
+			policy: func(scheduleID, teamID string) map[string]interface{} {
+				return map[string]interface{}{
+					"name":          "tf-acc-escalation-policy-upgrade",
+					"description":   "Dynamic",
+					"repetitions":   0,
+					"step_strategy": "dynamic_by_priority",
+					"steps":         []interface{}{map[string]interface{}{"timeout": "PT1M", "priorities": []interface{}{"HIGH", "LOW"}, "targets": []interface{}{map[string]interface{}{"type": "OnCallSchedule", "id": scheduleID}}}},
+					"prioritized_settings": map[string]interface{}{
+						"high": map[string]interface{}{"repetitions": 2},
+						"low":  map[string]interface{}{},
+					},
+				}
+			},
+			config: func(scheduleID, teamID string) string {
+				return fmt.Sprintf(`
+resource "firehydrant_escalation_policy" "test_escalation_policy" {
+  team_id       = %[2]q
+  name          = "tf-acc-escalation-policy-upgrade"
+  description   = "Dynamic"
+  repetitions   = 0
+  step_strategy = "dynamic_by_priority"
+
+  step {
+    timeout = "PT1M"
+    targets {
+      type = "OnCallSchedule"
+      id   = %[1]q
+    }
+  }
+
+  notification_priority_policies {
+    priority    = "HIGH"
+    repetitions = 2
+  }
+
+  notification_priority_policies {
+    priority = "LOW"
+  }
+}
+`, scheduleID, teamID)
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := fakeapi.NewServer()
+			defer server.Close()
+			teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+			scheduleID := server.Seed("/v1/teams/"+teamID+"/on_call_schedules", map[string]interface{}{"name": "Primary"})[0]["id"].(string)
+			policiesPath := "/v1/teams/" + teamID + "/escalation_policies"
+			policyID := server.Seed(policiesPath, c.policy(scheduleID, teamID))[0]["id"].(string)
+			config := testFakeAPIProviderConfig(server) + c.config(scheduleID, teamID)
+
+			resource.UnitTest(t, resource.TestCase{
+				CheckDestroy: func(s *terraform.State) error {
+					if _, ok := server.Get(policiesPath + "/" + policyID); ok {
+						return fmt.Errorf("escalation policy %s still exists", policyID)
+					}
+					return nil
+				},
+				Steps: []resource.TestStep{
+					{
+						ProtoV5ProviderFactories: testSDKEscalationPolicyProviderFactories(policyID),
+						Config:                   config,
+						// The plugin SDK resource showed a diff for the step strategy and
+						// priorities the API sets when they aren't configured
+						ExpectNonEmptyPlan: true,
+					},
+					{
+						// The state written by the plugin SDK resource is upgraded, and the
+						// plan is empty
+						ProtoV5ProviderFactories: defaultProviderFactories(),
+						Config:                   config,
+						PlanOnly:                 true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestOfflineEscalationPolicyResource_upgradeFromSDKWithoutUpdate(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+	scheduleID := server.Seed("/v1/teams/"+teamID+"/on_call_schedules", map[string]interface{}{"name": "Primary"})[0]["id"].(string)
+	policiesPath := "/v1/teams/" + teamID + "/escalation_policies"
+	policyID := server.Seed(policiesPath, map[string]interface{}{
+		"name":        "tf-acc-escalation-policy-upgrade",
+		"repetitions": 1,
+		"steps":       []interface{}{map[string]interface{}{"timeout": "PT1M", "targets": []interface{}{map[string]interface{}{"type": "OnCallSchedule", "id": scheduleID}}}},
+	})[0]["id"].(string)
+	// The plugin SDK resource stored these the same way as when they aren't set
+	config := testFakeAPIProviderConfig(server) + fmt.Sprintf(`
+resource "firehydrant_escalation_policy" "test_escalation_policy" {
+  team_id       = %[2]q
+  name          = "tf-acc-escalation-policy-upgrade"
+  description   = ""
+  default       = false
+  repetitions   = 1
+  step_strategy = "static"
+
+  step {
+    timeout = "PT1M"
+    targets {
+      type = "OnCallSchedule"
+      id   = %[1]q
+    }
+  }
+}
+`, scheduleID, teamID)
+
+	resource.UnitTest(t, resource.TestCase{
+		CheckDestroy: func(s *terraform.State) error {
+			if _, ok := server.Get(policiesPath + "/" + policyID); ok {
+				return fmt.Errorf("escalation policy %s still exists", policyID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: testSDKEscalationPolicyProviderFactories(policyID),
+				Config:                   config,
+			},
+			{
+				// Only the state is rewritten, the policy isn't updated
+				ProtoV5ProviderFactories: defaultProviderFactories(),
+				Config:                   config,
+				Check: func(s *terraform.State) error {
+					if requests := server.Requests("PATCH", policiesPath+"/"+policyID); requests != 0 {
+						return fmt.Errorf("expected no update requests, got %d", requests)
+					}
+					return nil
+				},
+			},
+			{
+				ProtoV5ProviderFactories: defaultProviderFactories(),
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
@@ -0,0 +1,306 @@
+// Package export generates Terraform configuration for the objects that already exist in a
+// FireHydrant organization, so existing organizations can be brought under Terraform
+// without hand-writing every resource.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceType is a resource the exporter knows how to find in an organization
+type resourceType struct {
+	// name is the resource type without the provider prefix, as passed to --types
+	name string
+	// list returns every object of this type in the organization
+	list func(ctx context.Context, client *firehydrant.APIClient) ([]object, error)
+	// listForTeam returns every object of this type owned by a team, for resources
+	// that are imported as Team_ID:ID
+	listForTeam func(ctx context.Context, client *firehydrant.APIClient, teamID string) ([]object, error)
+}
+
+// resourceTypes are ordered so that objects only reference objects of an earlier type.
+// Only those references are rewritten to HCL references, which keeps the generated
+// configuration free of dependency cycles (e.g. a team membership pointing at an on-call
+// schedule that itself belongs to the team).
+var resourceTypes = []resourceType{
+	{name: "incident_role", list: listIncidentRoles},
+	{name: "severity", list: listSeverities},
+	{name: "environment", list: listEnvironments},
+	{name: "team", list: listTeams},
+	{name: "service", list: listServices},
+	{name: "functionality", list: listFunctionalities},
+	{name: "on_call_schedule", listForTeam: listOnCallSchedules},
+	{name: "escalation_policy", listForTeam: listEscalationPolicies},
+	{name: "signal_rule", listForTeam: listSignalRules},
+}
+
+// Command runs the export subcommand with the given command line arguments. The API client
+// is configured the same way as the provider, from the FIREHYDRANT_* environment variables.
+func Command(ctx context.Context, args []string, stderr io.Writer) error {
+	var typeNames []string
+	for _, t := range resourceTypes {
+		typeNames = append(typeNames, t.name)
+	}
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	types := flags.String("types", strings.Join(typeNames, ","), "comma separated list of resource types to export")
+	out := flags.String("out", ".", "directory the generated .tf files are written to")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: terraform-provider-firehydrant export [--types %s] [--out DIR]\n\n", strings.Join(typeNames, ","))
+		fmt.Fprintf(stderr, "Writes a resource block and a matching import block for every object of the given types.\n")
+		fmt.Fprintf(stderr, "The API key is read from FIREHYDRANT_API_KEY.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	server, err := provider.NewServer(ctx)
+	if err != nil {
+		return err
+	}
+	schemas, err := providerSchema(ctx, server)
+	if err != nil {
+		return err
+	}
+	config, err := tfprotov5.NewDynamicValue(schemas.Provider.ValueType(), nullObject(schemas.Provider.Block, nil))
+	if err != nil {
+		return err
+	}
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{TerraformVersion: "export", Config: &config})
+	if err != nil {
+		return err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return fmt.Errorf("could not configure provider: %w", err)
+	}
+
+	files, err := Export(ctx, server, strings.Split(*types, ","))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	for name, content := range files {
+		path := filepath.Join(*out, name)
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(stderr, "Wrote %s\n", path)
+	}
+	return nil
+}
+
+// Export reads every object of the given types through the configured provider and returns
+// the generated configuration, one file per resource type
+func Export(ctx context.Context, server *provider.Server, types []string) (map[string][]byte, error) {
+	client := server.Client()
+	if client == nil {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+	schemas, err := providerSchema(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := map[string]bool{}
+	for _, name := range types {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "firehydrant_")
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, t := range resourceTypes {
+			found = found || t.name == name
+		}
+		if !found {
+			return nil, fmt.Errorf("unsupported resource type %q", name)
+		}
+		selected[name] = true
+	}
+
+	var teams []object
+	g := &generator{refs: map[string]address{}}
+	files := map[string][]byte{}
+	for _, t := range resourceTypes {
+		if !selected[t.name] {
+			continue
+		}
+
+		var objects []object
+		if t.listForTeam == nil {
+			var err error
+			if objects, err = t.list(ctx, client); err != nil {
+				return nil, fmt.Errorf("could not list %s: %w", t.name, err)
+			}
+		} else {
+			if teams == nil {
+				var err error
+				if teams, err = listTeams(ctx, client); err != nil {
+					return nil, fmt.Errorf("could not list teams: %w", err)
+				}
+			}
+			for _, team := range teams {
+				teamObjects, err := t.listForTeam(ctx, client, team.ID)
+				if err != nil {
+					return nil, fmt.Errorf("could not list %s for team %s: %w", t.name, team.ID, err)
+				}
+				objects = append(objects, teamObjects...)
+			}
+		}
+
+		content, refs, err := g.render(ctx, server, schemas, t, objects)
+		if err != nil {
+			return nil, err
+		}
+		// Added after rendering so a type never references itself
+		for id, addr := range refs {
+			g.refs[id] = addr
+		}
+		if len(objects) > 0 {
+			files["firehydrant_"+t.name+".tf"] = content
+		}
+	}
+	return files, nil
+}
+
+// render reads each object with the resource's own read function and writes it as HCL
+func (g *generator) render(ctx context.Context, server tfprotov5.ProviderServer, schemas *tfprotov5.GetProviderSchemaResponse, t resourceType, objects []object) ([]byte, map[string]address, error) {
+	typeName := "firehydrant_" + t.name
+	s, ok := schemas.ResourceSchemas[typeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown resource type %s", typeName)
+	}
+
+	f := hclwrite.NewEmptyFile()
+	refs := map[string]address{}
+	used := map[string]bool{}
+	for _, o := range objects {
+		known := map[string]string{"id": o.ID}
+		importID := o.ID
+		if o.TeamID != "" {
+			known["team_id"] = o.TeamID
+			importID = o.TeamID + ":" + o.ID
+		}
+
+		values, err := readResource(ctx, server, typeName, s, known)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read %s %s: %w", typeName, o.ID, err)
+		}
+		if values == nil {
+			// Deleted since it was listed
+			continue
+		}
+
+		label := o.ID
+		for _, key := range []string{"name", "slug"} {
+			var v string
+			if values[key].IsKnown() && !values[key].IsNull() && values[key].As(&v) == nil && v != "" {
+				label = v
+				break
+			}
+		}
+		// Suffixed labels are checked too, an object may really be named e.g. payments_2
+		base := resourceName(label)
+		name := base
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[name] = true
+
+		addr := address{Type: typeName, Name: name}
+		g.writeResource(f.Body(), addr, importID, s.Block, values)
+		refs[o.ID] = addr
+	}
+	return hclwrite.Format(f.Bytes()), refs, nil
+}
+
+// readResource refreshes a resource that only has the known attributes set, and returns the
+// attributes of its new state, or nil if it no longer exists
+func readResource(ctx context.Context, server tfprotov5.ProviderServer, typeName string, s *tfprotov5.Schema, known map[string]string) (map[string]tftypes.Value, error) {
+	state, err := tfprotov5.NewDynamicValue(s.ValueType(), nullObject(s.Block, known))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{TypeName: typeName, CurrentState: &state})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+	if resp.NewState == nil {
+		return nil, nil
+	}
+
+	newState, err := resp.NewState.Unmarshal(s.ValueType())
+	if err != nil {
+		return nil, err
+	}
+	if newState.IsNull() {
+		return nil, nil
+	}
+	var values map[string]tftypes.Value
+	if err := newState.As(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func providerSchema(ctx context.Context, server tfprotov5.ProviderServer) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// nullObject returns an object of the block's type with the known string attributes set and
+// everything else null
+func nullObject(block *tfprotov5.SchemaBlock, known map[string]string) tftypes.Value {
+	objectType := block.ValueType().(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if v, ok := known[name]; ok {
+			values[name] = tftypes.NewValue(attributeType, v)
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// diagnosticsError returns the first error of a protocol response
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			if d.Detail != "" {
+				return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+			}
+			return errors.New(d.Summary)
+		}
+	}
+	return nil
+}
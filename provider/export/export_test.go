@@ -0,0 +1,66 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+)
+
+func TestCommand(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	teams := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})
+	teamID := teams[0]["id"].(string)
+	services := server.Seed("/v1/services",
+		map[string]interface{}{"name": "Payments", "owner": map[string]interface{}{"id": teamID}},
+		map[string]interface{}{"name": "Payments"},
+		map[string]interface{}{"name": "Payments 2"},
+	)
+
+	t.Setenv("FIREHYDRANT_API_KEY", "test-token-very-authorized")
+	t.Setenv("FIREHYDRANT_BASE_URL", server.BaseURL())
+	out := t.TempDir()
+	var stderr bytes.Buffer
+	if err := Command(context.Background(), []string{"--types", "team,service", "--out", out}, &stderr); err != nil {
+		t.Fatalf("Received error running export: %s (output: %s)", err.Error(), stderr.String())
+	}
+
+	teamConfig, err := os.ReadFile(filepath.Join(out, "firehydrant_team.tf"))
+	if err != nil {
+		t.Fatalf("Received error reading generated teams: %s", err.Error())
+	}
+	for _, expected := range []string{
+		"import {\n  to = firehydrant_team.payments\n  id = \"" + teamID + "\"\n}",
+		"resource \"firehydrant_team\" \"payments\" {",
+	} {
+		if !strings.Contains(string(teamConfig), expected) {
+			t.Fatalf("Expected generated teams to contain:\n%s\ngot:\n%s", expected, teamConfig)
+		}
+	}
+
+	serviceConfig, err := os.ReadFile(filepath.Join(out, "firehydrant_service.tf"))
+	if err != nil {
+		t.Fatalf("Received error reading generated services: %s", err.Error())
+	}
+	for _, expected := range []string{
+		"import {\n  to = firehydrant_service.payments\n  id = \"" + services[0]["id"].(string) + "\"\n}",
+		"import {\n  to = firehydrant_service.payments_2\n  id = \"" + services[1]["id"].(string) + "\"\n}",
+		// The object really named payments_2 doesn't collide with the second Payments
+		"import {\n  to = firehydrant_service.payments_2_2\n  id = \"" + services[2]["id"].(string) + "\"\n}",
+		"resource \"firehydrant_service\" \"payments_2_2\" {",
+		"owner_id     = firehydrant_team.payments.id",
+	} {
+		if !strings.Contains(string(serviceConfig), expected) {
+			t.Fatalf("Expected generated services to contain:\n%s\ngot:\n%s", expected, serviceConfig)
+		}
+	}
+	if strings.Contains(string(serviceConfig), teamID) {
+		t.Fatalf("Expected the team ID to be rewritten as a reference, got:\n%s", serviceConfig)
+	}
+}
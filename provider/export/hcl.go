@@ -0,0 +1,214 @@
+package export
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// address identifies a resource block in the generated configuration
+type address struct {
+	Type string
+	Name string
+}
+
+func (a address) String() string {
+	return a.Type + "." + a.Name
+}
+
+// generator renders resources read through the provider's own schema as HCL. IDs found in
+// refs are written as references to the resource that was exported for them.
+type generator struct {
+	refs map[string]address
+}
+
+// writeResource appends the import and resource blocks for a single object to body
+func (g *generator) writeResource(body *hclwrite.Body, addr address, importID string, s *tfprotov5.SchemaBlock, values map[string]tftypes.Value) {
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: addr.Type},
+		hcl.TraverseAttr{Name: addr.Name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{addr.Type, addr.Name})
+	g.writeBody(resourceBlock.Body(), s, values)
+	body.AppendNewline()
+}
+
+// writeBody writes every configurable attribute of s, attributes first and nested blocks
+// after them, each in alphabetical order so the output is stable between runs.
+func (g *generator) writeBody(body *hclwrite.Body, s *tfprotov5.SchemaBlock, values map[string]tftypes.Value) {
+	attributes := append([]*tfprotov5.SchemaAttribute(nil), s.Attributes...)
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Name < attributes[j].Name })
+	for _, attr := range attributes {
+		if !configurable(attr) {
+			continue
+		}
+		v := values[attr.Name]
+		if !v.IsKnown() || v.IsNull() || (!attr.Required && omit(v)) {
+			continue
+		}
+		body.SetAttributeRaw(attr.Name, g.tokens(attr.Name, v))
+	}
+
+	blocks := append([]*tfprotov5.SchemaNestedBlock(nil), s.BlockTypes...)
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].TypeName < blocks[j].TypeName })
+	for _, nested := range blocks {
+		v := values[nested.TypeName]
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		items := []tftypes.Value{v}
+		if nested.Nesting == tfprotov5.SchemaNestedBlockNestingModeList || nested.Nesting == tfprotov5.SchemaNestedBlockNestingModeSet {
+			if err := v.As(&items); err != nil {
+				continue
+			}
+		}
+		for _, item := range items {
+			var m map[string]tftypes.Value
+			if err := item.As(&m); err != nil || m == nil {
+				continue
+			}
+			block := body.AppendNewBlock(nested.TypeName, nil)
+			g.writeBody(block.Body(), nested.Block, m)
+		}
+	}
+}
+
+// tokens renders a single attribute value
+func (g *generator) tokens(key string, v tftypes.Value) hclwrite.Tokens {
+	if !v.IsKnown() || v.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var items []tftypes.Value
+		_ = v.As(&items)
+		elems := make([]hclwrite.Tokens, 0, len(items))
+		for _, item := range items {
+			elems = append(elems, g.tokens(key, item))
+		}
+		if typ.Is(tftypes.Set{}) {
+			sort.Slice(elems, func(i, j int) bool {
+				return string(elems[i].Bytes()) < string(elems[j].Bytes())
+			})
+		}
+		return hclwrite.TokensForTuple(elems)
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var m map[string]tftypes.Value
+		_ = v.As(&m)
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(m))
+		for _, name := range names {
+			nameTokens := hclwrite.TokensForValue(cty.StringVal(name))
+			if hclsyntax.ValidIdentifier(name) {
+				nameTokens = hclwrite.TokensForIdentifier(name)
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  nameTokens,
+				Value: g.tokens(name, m[name]),
+			})
+		}
+		return hclwrite.TokensForObject(attrs)
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		_ = v.As(&n)
+		return hclwrite.TokensForValue(cty.NumberVal(n))
+	default:
+		var s string
+		_ = v.As(&s)
+		if addr, ok := g.refs[s]; ok && isReferenceKey(key) {
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: addr.Type},
+				hcl.TraverseAttr{Name: addr.Name},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s))
+	}
+}
+
+// configurable reports whether an attribute belongs in configuration. Computed-only
+// attributes can't be set, deprecated ones usually conflict with their replacement and
+// sensitive values are never returned by the API. The plugin SDK makes every resource's id
+// optional, but it's set by the import block.
+func configurable(attr *tfprotov5.SchemaAttribute) bool {
+	if attr.Computed && (attr.Name == "id" || !attr.Optional && !attr.Required) {
+		return false
+	}
+	return !attr.Deprecated && !attr.Sensitive
+}
+
+// omit reports whether an optional attribute can be left out because it has its zero value.
+// Defaults aren't part of the plugin protocol's schema, so a non-zero default is written out.
+func omit(v tftypes.Value) bool {
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		return v.As(&n) == nil && n.Sign() == 0
+	case typ.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var m map[string]tftypes.Value
+		return v.As(&m) == nil && len(m) == 0
+	default:
+		var items []tftypes.Value
+		return v.As(&items) == nil && len(items) == 0
+	}
+}
+
+// isReferenceKey reports whether an attribute holds the ID of another object, e.g. team_id,
+// owner_id, member_ids or the id of an escalation policy target
+func isReferenceKey(key string) bool {
+	return key == "id" || strings.HasSuffix(key, "_id") || strings.HasSuffix(key, "_ids")
+}
+
+// resourceName turns a display name into a valid, lowercase Terraform identifier
+func resourceName(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+
+	s := strings.TrimSuffix(b.String(), "_")
+	if s == "" {
+		return "unnamed"
+	}
+	if unicode.IsDigit(rune(s[0])) {
+		s = "_" + s
+	}
+	return s
+}
@@ -0,0 +1,123 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWriteResource(t *testing.T) {
+	target := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "type", Type: tftypes.String, Required: true},
+			{Name: "id", Type: tftypes.String, Required: true},
+		},
+	}
+	step := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "timeout", Type: tftypes.String, Required: true},
+		},
+		BlockTypes: []*tfprotov5.SchemaNestedBlock{
+			{TypeName: "targets", Nesting: tfprotov5.SchemaNestedBlockNestingModeList, Block: target},
+		},
+	}
+	s := &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "name", Type: tftypes.String, Required: true},
+			{Name: "description", Type: tftypes.String, Optional: true},
+			{Name: "team_id", Type: tftypes.String, Required: true},
+			{Name: "service_tier", Type: tftypes.Number, Optional: true},
+			{Name: "slug", Type: tftypes.String, Computed: true},
+			{Name: "members", Type: tftypes.List{ElementType: tftypes.String}, Optional: true, Deprecated: true},
+			{Name: "labels", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true},
+			{Name: "member_ids", Type: tftypes.List{ElementType: tftypes.String}, Optional: true},
+		},
+		BlockTypes: []*tfprotov5.SchemaNestedBlock{
+			{TypeName: "step", Nesting: tfprotov5.SchemaNestedBlockNestingModeList, Block: step},
+		},
+	}
+
+	targetValue := func(targetType, id string) tftypes.Value {
+		return tftypes.NewValue(target.ValueType(), map[string]tftypes.Value{
+			"type": tftypes.NewValue(tftypes.String, targetType),
+			"id":   tftypes.NewValue(tftypes.String, id),
+		})
+	}
+	values := map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "Payments"),
+		"description":  tftypes.NewValue(tftypes.String, ""),
+		"team_id":      tftypes.NewValue(tftypes.String, "team-1"),
+		"service_tier": tftypes.NewValue(tftypes.Number, 0),
+		"slug":         tftypes.NewValue(tftypes.String, "payments"),
+		"members":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "user-1")}),
+		"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"tier":        tftypes.NewValue(tftypes.String, "one"),
+			"cost-center": tftypes.NewValue(tftypes.String, "42"),
+		}),
+		"member_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "user-1")}),
+		"step": tftypes.NewValue(tftypes.List{ElementType: step.ValueType()}, []tftypes.Value{
+			tftypes.NewValue(step.ValueType(), map[string]tftypes.Value{
+				"timeout": tftypes.NewValue(tftypes.String, "PT5M"),
+				"targets": tftypes.NewValue(tftypes.List{ElementType: target.ValueType()}, []tftypes.Value{
+					targetValue("OnCallSchedule", "schedule-1"),
+					targetValue("User", "user-1"),
+				}),
+			}),
+		}),
+	}
+
+	g := &generator{refs: map[string]address{
+		"team-1":     {Type: "firehydrant_team", Name: "platform"},
+		"schedule-1": {Type: "firehydrant_on_call_schedule", Name: "primary"},
+	}}
+	f := hclwrite.NewEmptyFile()
+	g.writeResource(f.Body(), address{Type: "firehydrant_escalation_policy", Name: "payments"}, "team-1:policy-1", s, values)
+
+	expected := `import {
+  to = firehydrant_escalation_policy.payments
+  id = "team-1:policy-1"
+}
+
+resource "firehydrant_escalation_policy" "payments" {
+  labels = {
+    cost-center = "42"
+    tier        = "one"
+  }
+  member_ids = ["user-1"]
+  name       = "Payments"
+  team_id    = firehydrant_team.platform.id
+  step {
+    timeout = "PT5M"
+    targets {
+      id   = firehydrant_on_call_schedule.primary.id
+      type = "OnCallSchedule"
+    }
+    targets {
+      id   = "user-1"
+      type = "User"
+    }
+  }
+}
+
+`
+	if got := string(hclwrite.Format(f.Bytes())); got != expected {
+		t.Fatalf("Unexpected configuration, expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"Payments API":       "payments_api",
+		"  SEV-1 (critical)": "sev_1_critical",
+		"24/7 Support":       "_24_7_support",
+		"!!!":                "unnamed",
+	}
+
+	for input, expected := range tests {
+		if got := resourceName(input); got != expected {
+			t.Errorf("resourceName(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}
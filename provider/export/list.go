@@ -0,0 +1,184 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const listPerPage = 100
+
+// object is a single FireHydrant object found while walking the organization
+type object struct {
+	ID     string
+	TeamID string
+}
+
+// listAll pages through an SDK list endpoint and converts every entity to an object
+func listAll[TRequest any, TEntity any](
+	ctx context.Context,
+	client *firehydrant.APIClient,
+	request *TRequest,
+	setPage func(request *TRequest, page *int),
+	getPage func(ctx context.Context, request *TRequest) (pagination.PaginateResponse[TEntity], error),
+	toObject func(entity TEntity) object,
+) ([]object, error) {
+	entities, diags := pagination.Paginate(ctx, pagination.PaginateRequestOptions[TRequest, TEntity]{
+		Client:             client,
+		Request:            request,
+		SetRequestPageFunc: setPage,
+		GetPageFunc: func(ctx context.Context, _ *firehydrant.APIClient, request *TRequest) (pagination.PaginateResponse[TEntity], diag.Diagnostics) {
+			response, err := getPage(ctx, request)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			return response, nil
+		},
+	})
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	objects := make([]object, 0, len(entities))
+	for _, entity := range entities {
+		if o := toObject(entity); o.ID != "" {
+			objects = append(objects, o)
+		}
+	}
+	return objects, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func listIncidentRoles(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListIncidentRolesRequest{PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListIncidentRolesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListIncidentRolesRequest) (pagination.PaginateResponse[components.IncidentRoleEntity], error) {
+			return client.Sdk.IncidentSettings.ListIncidentRoles(ctx, request.Page, request.PerPage)
+		},
+		func(incidentRole components.IncidentRoleEntity) object {
+			// Archived roles can't be managed anymore
+			if incidentRole.GetDiscardedAt() != nil {
+				return object{}
+			}
+			return object{ID: stringValue(incidentRole.GetID())}
+		},
+	)
+}
+
+func listSeverities(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListSeveritiesRequest{PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListSeveritiesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListSeveritiesRequest) (pagination.PaginateResponse[components.SeverityEntity], error) {
+			return client.Sdk.IncidentSettings.ListSeverities(ctx, request.Page, request.PerPage)
+		},
+		func(severity components.SeverityEntity) object {
+			// Severities are identified by their slug
+			return object{ID: stringValue(severity.GetSlug())}
+		},
+	)
+}
+
+func listEnvironments(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListEnvironmentsRequest{PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListEnvironmentsRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListEnvironmentsRequest) (pagination.PaginateResponse[components.EnvironmentEntryEntity], error) {
+			return client.Sdk.CatalogEntries.ListEnvironments(ctx, request.Page, request.PerPage, request.Query, request.Name)
+		},
+		func(environment components.EnvironmentEntryEntity) object {
+			return object{ID: stringValue(environment.GetID())}
+		},
+	)
+}
+
+func listTeams(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListTeamsRequest{PerPage: ptr.Of(listPerPage), Lite: ptr.Of(true)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListTeamsRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListTeamsRequest) (pagination.PaginateResponse[components.TeamEntity], error) {
+			return client.Sdk.Teams.ListTeams(ctx, *request)
+		},
+		func(team components.TeamEntity) object {
+			return object{ID: stringValue(team.GetID())}
+		},
+	)
+}
+
+func listServices(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListServicesRequest{PerPage: ptr.Of(listPerPage), Lite: ptr.Of(true)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListServicesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListServicesRequest) (pagination.PaginateResponse[components.ServiceEntity], error) {
+			return client.Sdk.CatalogEntries.ListServices(ctx, *request)
+		},
+		func(service components.ServiceEntity) object {
+			return object{ID: stringValue(service.GetID())}
+		},
+	)
+}
+
+func listFunctionalities(ctx context.Context, client *firehydrant.APIClient) ([]object, error) {
+	request := operations.ListFunctionalitiesRequest{PerPage: ptr.Of(listPerPage), Lite: ptr.Of(true)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListFunctionalitiesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListFunctionalitiesRequest) (pagination.PaginateResponse[components.FunctionalityEntity], error) {
+			return client.Sdk.CatalogEntries.ListFunctionalities(ctx, *request)
+		},
+		func(functionality components.FunctionalityEntity) object {
+			return object{ID: stringValue(functionality.GetID())}
+		},
+	)
+}
+
+func listOnCallSchedules(ctx context.Context, client *firehydrant.APIClient, teamID string) ([]object, error) {
+	request := operations.ListTeamOnCallSchedulesRequest{TeamID: teamID, PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListTeamOnCallSchedulesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListTeamOnCallSchedulesRequest) (pagination.PaginateResponse[components.SignalsAPIOnCallScheduleEntity], error) {
+			return client.Sdk.Signals.ListTeamOnCallSchedules(ctx, *request)
+		},
+		func(schedule components.SignalsAPIOnCallScheduleEntity) object {
+			return object{ID: stringValue(schedule.GetID()), TeamID: teamID}
+		},
+	)
+}
+
+func listEscalationPolicies(ctx context.Context, client *firehydrant.APIClient, teamID string) ([]object, error) {
+	request := operations.ListTeamEscalationPoliciesRequest{TeamID: teamID, PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListTeamEscalationPoliciesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListTeamEscalationPoliciesRequest) (pagination.PaginateResponse[components.SignalsAPIEscalationPolicyEntity], error) {
+			return client.Sdk.Signals.ListTeamEscalationPolicies(ctx, request.TeamID, request.Query, request.Page, request.PerPage)
+		},
+		func(policy components.SignalsAPIEscalationPolicyEntity) object {
+			return object{ID: stringValue(policy.GetID()), TeamID: teamID}
+		},
+	)
+}
+
+func listSignalRules(ctx context.Context, client *firehydrant.APIClient, teamID string) ([]object, error) {
+	request := operations.ListTeamSignalRulesRequest{TeamID: teamID, PerPage: ptr.Of(listPerPage)}
+	return listAll(ctx, client, &request,
+		func(request *operations.ListTeamSignalRulesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListTeamSignalRulesRequest) (pagination.PaginateResponse[components.SignalsAPIRuleEntity], error) {
+			return client.Sdk.Signals.ListTeamSignalRules(ctx, request.TeamID, request.Query, request.Page, request.PerPage)
+		},
+		func(rule components.SignalsAPIRuleEntity) object {
+			return object{ID: stringValue(rule.GetID()), TeamID: teamID}
+		},
+	)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkClient returns the API client the plugin framework passes to a resource's
+// Configure, which is nil until the provider itself is configured
+func frameworkClient(providerData any, diags *diag.Diagnostics) *firehydrant.APIClient {
+	if providerData == nil {
+		return nil
+	}
+	client, ok := providerData.(*firehydrant.APIClient)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("Expected *firehydrant.APIClient, got %T", providerData))
+		return nil
+	}
+	return client
+}
+
+// stateSetter is the part of tfsdk.State that resources write their model with
+type stateSetter interface {
+	Set(ctx context.Context, val interface{}) diag.Diagnostics
+}
+
+// intValue dereferences an optional integer returned by the SDK, treating nil as zero
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// int64Pointer converts an optional integer returned by the SDK for types.Int64PointerValue
+func int64Pointer(i *int) *int64 {
+	if i == nil {
+		return nil
+	}
+	return ptr.Of(int64(*i))
+}
+
+// intPointer converts an optional integer of a model for a request, nil unless it's known
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return ptr.Of(int(v.ValueInt64()))
+}
+
+// stringValueOrNull returns a known string, or null for a missing or empty one
+func stringValueOrNull(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+// stringsEqual compares known strings, treating an empty string and null as the same.
+// Unknown strings are never equal.
+func stringsEqual(a, b types.String) bool {
+	return !a.IsUnknown() && !b.IsUnknown() && a.ValueString() == b.ValueString()
+}
+
+// stringsEqualFold is like stringsEqual, but ignores case
+func stringsEqualFold(a, b types.String) bool {
+	return !a.IsUnknown() && !b.IsUnknown() && strings.EqualFold(a.ValueString(), b.ValueString())
+}
+
+// allKnown reports whether none of the values is unknown
+func allKnown(values ...types.String) bool {
+	for _, v := range values {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// requiresReplaceIfChanged is like stringplanmodifier.RequiresReplace, but doesn't treat an
+// empty string and null as a change. State written by the plugin SDK has empty strings where
+// the plugin framework has null, which must not replace resources created before a
+// resource moved to the plugin framework.
+func requiresReplaceIfChanged() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !stringsEqual(req.PlanValue, req.StateValue)
+		},
+		"Changing this value replaces the resource.",
+		"Changing this value replaces the resource.",
+	)
+}
+
+// caseInsensitive plans the value in state when the configured value only differs from it in
+// case, for values the API doesn't distinguish by case. It's what a DiffSuppressFunc comparing
+// with strings.EqualFold does for the plugin SDK.
+type caseInsensitive struct{}
+
+var _ planmodifier.String = caseInsensitive{}
+
+func (m caseInsensitive) Description(ctx context.Context) string {
+	return "Values that only differ in case are the same."
+}
+
+func (m caseInsensitive) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m caseInsensitive) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if strings.EqualFold(req.ConfigValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// keepCase returns current when it's value in another case, so that reading a value the API
+// returns in lowercase keeps the case it's configured in
+func keepCase(current types.String, value string) types.String {
+	if !current.IsUnknown() && strings.EqualFold(current.ValueString(), value) {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// rfc3339Validator checks that a string is an RFC3339 timestamp
+type rfc3339Validator struct{}
+
+var _ validator.String = rfc3339Validator{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s timestamp", req.Path),
+			fmt.Sprintf("%s must be a valid RFC3339 timestamp (e.g. 2024-01-01T15:04:05Z), got: %s", req.Path, value))
+	}
+}
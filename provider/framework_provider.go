@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkProvider is the plugin framework half of the provider. Its schema has to be
+// identical to the plugin SDK provider's for the two to be served together, and it leaves
+// validating the configuration to the plugin SDK provider, so errors aren't reported twice.
+type frameworkProvider struct {
+	client *configuredClient
+}
+
+var (
+	_ fwprovider.Provider                       = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+func newFrameworkProvider(client *configuredClient) fwprovider.Provider {
+	return &frameworkProvider{client: client}
+}
+
+// frameworkProviderModel is the provider block as read by the plugin framework
+type frameworkProviderModel struct {
+	APIKey             types.String  `tfsdk:"api_key"`
+	APIKeyFile         types.String  `tfsdk:"api_key_file"`
+	APIKeyCommand      types.List    `tfsdk:"api_key_command"`
+	BaseURL            types.String  `tfsdk:"firehydrant_base_url"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait       types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	ClientCert         types.String  `tfsdk:"client_cert"`
+	ClientKey          types.String  `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	ReadCache          types.Bool    `tfsdk:"read_cache"`
+	DefaultLabels      types.Map     `tfsdk:"default_labels"`
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "firehydrant"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	sdkSchema := Provider().Schema
+	description := func(name string) string {
+		return sdkSchema[name].Description
+	}
+
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			apiKeyName: fwschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: description(apiKeyName),
+			},
+			apiKeyFileName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(apiKeyFileName),
+			},
+			apiKeyCommandName: fwschema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: description(apiKeyCommandName),
+			},
+			firehydrantBaseURLName: fwschema.StringAttribute{
+				Optional: true,
+			},
+			maxRetriesName: fwschema.Int64Attribute{
+				Optional:    true,
+				Description: description(maxRetriesName),
+			},
+			retryMaxWaitName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(retryMaxWaitName),
+			},
+			requestsPerSecondName: fwschema.Float64Attribute{
+				Optional:    true,
+				Description: description(requestsPerSecondName),
+			},
+			proxyURLName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(proxyURLName),
+			},
+			caCertPEMName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(caCertPEMName),
+			},
+			caCertFileName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(caCertFileName),
+			},
+			clientCertName: fwschema.StringAttribute{
+				Optional:    true,
+				Description: description(clientCertName),
+			},
+			clientKeyName: fwschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: description(clientKeyName),
+			},
+			insecureSkipVerifyName: fwschema.BoolAttribute{
+				Optional:    true,
+				Description: description(insecureSkipVerifyName),
+			},
+			readCacheName: fwschema.BoolAttribute{
+				Optional:    true,
+				Description: description(readCacheName),
+			},
+			defaultLabelsName: fwschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: description(defaultLabelsName),
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	var model frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := model.providerConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terraformVersion := req.TerraformVersion
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+	client, err := p.client.get(ctx, config, terraformVersion)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+		return
+	}
+	resp.ResourceData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newEscalationPolicyResource,
+		newOnCallScheduleResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newIngestURLEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newAttachmentRuleFunction,
+		newDurationFunction,
+		newSignalsTargetFunction,
+	}
+}
+
+// providerConfig applies the same defaults as the plugin SDK provider's schema, so both
+// read the same configuration from the same provider block
+func (m frameworkProviderModel) providerConfig(ctx context.Context) (providerConfig, diag.Diagnostics) {
+	config := providerConfig{
+		apiKey:             m.APIKey.ValueString(),
+		apiKeyFile:         m.APIKeyFile.ValueString(),
+		baseURL:            m.BaseURL.ValueString(),
+		maxRetries:         int(m.MaxRetries.ValueInt64()),
+		retryMaxWait:       m.RetryMaxWait.ValueString(),
+		requestsPerSecond:  m.RequestsPerSecond.ValueFloat64(),
+		proxyURL:           m.ProxyURL.ValueString(),
+		caCertPEM:          m.CACertPEM.ValueString(),
+		caCertFile:         m.CACertFile.ValueString(),
+		clientCert:         m.ClientCert.ValueString(),
+		clientKey:          m.ClientKey.ValueString(),
+		insecureSkipVerify: m.InsecureSkipVerify.ValueBool(),
+		readCache:          m.ReadCache.ValueBool(),
+		defaultLabels:      map[string]string{},
+	}
+
+	if m.BaseURL.IsNull() {
+		config.baseURL = envDefault("FIREHYDRANT_BASE_URL", defaultBaseURL)
+	}
+	if m.MaxRetries.IsNull() {
+		config.maxRetries = firehydrant.DefaultMaxRetries
+	}
+	if m.RetryMaxWait.IsNull() {
+		config.retryMaxWait = firehydrant.DefaultRetryMaxWait.String()
+	}
+	if m.RequestsPerSecond.IsNull() {
+		if v, err := strconv.ParseFloat(os.Getenv("FIREHYDRANT_REQUESTS_PER_SECOND"), 64); err == nil {
+			config.requestsPerSecond = v
+		}
+	}
+	if m.ProxyURL.IsNull() {
+		config.proxyURL = os.Getenv("FIREHYDRANT_PROXY_URL")
+	}
+	if m.CACertFile.IsNull() {
+		config.caCertFile = os.Getenv("FIREHYDRANT_CA_CERT_FILE")
+	}
+
+	var diags diag.Diagnostics
+	if !m.APIKeyCommand.IsNull() && !m.APIKeyCommand.IsUnknown() {
+		diags.Append(m.APIKeyCommand.ElementsAs(ctx, &config.apiKeyCommand, false)...)
+	}
+	if !m.DefaultLabels.IsNull() && !m.DefaultLabels.IsUnknown() {
+		diags.Append(m.DefaultLabels.ElementsAs(ctx, &config.defaultLabels, false)...)
+	}
+	return config, diags
+}
+
+func envDefault(name, defaultValue string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return defaultValue
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,7 +71,7 @@ func dataFireHydrantFunctionality(ctx context.Context, d *schema.ResourceData, m
 	})
 	functionalityResponse, err := client.Sdk.CatalogEntries.GetFunctionality(ctx, functionalityID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return diag.Errorf("Functionality %s not found", functionalityID)
 		}
 		return diag.Errorf("Error reading functionality %s: %v", functionalityID, err)
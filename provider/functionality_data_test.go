@@ -13,15 +13,15 @@ func TestAccFunctionalityDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rName)),
+						"data.firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rName)),
 				),
 			},
 		},
@@ -33,15 +33,15 @@ func TestAccFunctionalityDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rName)),
+						"data.firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_functionality.test_functionality", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr("data.firehydrant_functionality.test_functionality", "service_ids.#", "1"),
@@ -54,7 +54,7 @@ func TestAccFunctionalityDataSource_allAttributes(t *testing.T) {
 func testAccFunctionalityDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_functionality" "test_functionality" {
-  name = "test-functionality-%s"
+  name = "tf-acc-functionality-%s"
 }
 
 data "firehydrant_functionality" "test_functionality" {
@@ -65,11 +65,11 @@ data "firehydrant_functionality" "test_functionality" {
 func testAccFunctionalityDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name = "test-service-%s"
+  name = "tf-acc-service-%s"
 }
 
 resource "firehydrant_functionality" "test_functionality" {
-  name = "test-functionality-%s"
+  name = "tf-acc-functionality-%s"
   description = "test-description-%s"
   service_ids = [firehydrant_service.test_service.id]
   labels = {
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +20,10 @@ func resourceFunctionality() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantFunctionality,
 		ReadContext:   readResourceFireHydrantFunctionality,
 		DeleteContext: deleteResourceFireHydrantFunctionality,
+		CustomizeDiff: customizeDiffLabels,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: functionalityImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -45,6 +48,7 @@ func resourceFunctionality() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"labels_all": labelsAllSchema(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -76,7 +80,7 @@ func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resourc
 	})
 	functionalityResponse, err := client.Sdk.CatalogEntries.GetFunctionality(ctx, functionalityID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Functionality %s no longer exists", functionalityID), map[string]interface{}{
 				"id": functionalityID,
 			})
@@ -108,7 +112,6 @@ func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resourc
 		"name":                     *functionalityResponse.Name,
 		"description":              description,
 		"auto_add_responding_team": autoAddRespondingTeam,
-		"labels":                   labelsMap,
 	}
 
 	// Process service IDs
@@ -143,6 +146,10 @@ func readResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if err := setLabels(client, d, labelsMap); err != nil {
+		return diag.Errorf("Error setting labels for functionality %s: %v", functionalityID, err)
+	}
+
 	return diag.Diagnostics{}
 }
 
@@ -154,7 +161,7 @@ func createResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	autoAddRespondingTeam := d.Get("auto_add_responding_team").(bool)
-	labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+	labels := mergedLabels(client, d.Get("labels").(map[string]interface{}))
 
 	createRequest := components.CreateFunctionality{
 		Name:                  name,
@@ -210,7 +217,7 @@ func updateResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	autoAddRespondingTeam := d.Get("auto_add_responding_team").(bool)
-	labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+	labels := mergedLabels(client, d.Get("labels").(map[string]interface{}))
 
 	removeRemainingServices := true
 	updateRequest := components.UpdateFunctionality{
@@ -276,7 +283,7 @@ func deleteResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 	functionalityID := d.Id()
 	err := client.Sdk.CatalogEntries.DeleteFunctionality(ctx, functionalityID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting functionality %s: %v", functionalityID, err)
@@ -284,3 +291,23 @@ func deleteResourceFireHydrantFunctionality(ctx context.Context, d *schema.Resou
 
 	return diag.Diagnostics{}
 }
+
+var functionalityImportLookup = importLookup{
+	resourceName: "functionality",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListFunctionalitiesRequest{PerPage: ptr.Of(importLookupPerPage)}
+		if field == "name" {
+			request.Name = &value
+		}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListFunctionalitiesRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListFunctionalitiesRequest) (pagination.PaginateResponse[components.FunctionalityEntity], error) {
+				return client.Sdk.CatalogEntries.ListFunctionalities(ctx, *request)
+			},
+			func(functionality components.FunctionalityEntity) importCandidate {
+				return importCandidate{ID: stringValue(functionality.GetID()), Name: stringValue(functionality.GetName()), Slug: stringValue(functionality.GetSlug())}
+			},
+		)
+	},
+}
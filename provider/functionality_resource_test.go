@@ -15,9 +15,9 @@ func TestAccFunctionalityResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFunctionalityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFunctionalityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityResourceConfig_basic(rName),
@@ -25,7 +25,7 @@ func TestAccFunctionalityResource_basic(t *testing.T) {
 					testAccCheckFunctionalityResourceExistsWithAttributes_basic("firehydrant_functionality.test_functionality"),
 					resource.TestCheckResourceAttrSet("firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rName)),
+						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "service_ids.#", "0"),
 				),
 			},
@@ -39,9 +39,9 @@ func TestAccFunctionalityResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFunctionalityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFunctionalityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityResourceConfig_basic(rName),
@@ -49,7 +49,7 @@ func TestAccFunctionalityResource_update(t *testing.T) {
 					testAccCheckFunctionalityResourceExistsWithAttributes_basic("firehydrant_functionality.test_functionality"),
 					resource.TestCheckResourceAttrSet("firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rName)),
+						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_functionality.test_functionality", "service_ids.#", "0"),
 				),
@@ -60,7 +60,7 @@ func TestAccFunctionalityResource_update(t *testing.T) {
 					testAccCheckFunctionalityResourceExistsWithAttributes_update("firehydrant_functionality.test_functionality"),
 					resource.TestCheckResourceAttrSet("firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rNameUpdated)),
+						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_functionality.test_functionality", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
@@ -76,7 +76,7 @@ func TestAccFunctionalityResource_update(t *testing.T) {
 					testAccCheckFunctionalityResourceExistsWithAttributes_basic("firehydrant_functionality.test_functionality"),
 					resource.TestCheckResourceAttrSet("firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rNameUpdated)),
+						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_functionality.test_functionality", "service_ids.#", "0"),
 				),
@@ -90,8 +90,8 @@ func TestAccFunctionalityResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityResourceConfig_basic(rName),
@@ -111,8 +111,8 @@ func TestAccFunctionalityResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityResourceConfig_update(rName),
@@ -132,9 +132,9 @@ func TestAccFunctionalityResource_withoutAutoAddRespondingTeam(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFunctionalityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFunctionalityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFunctionalityResourceConfig_withoutAutoAddRespondingTeam(rName),
@@ -142,7 +142,7 @@ func TestAccFunctionalityResource_withoutAutoAddRespondingTeam(t *testing.T) {
 					testAccCheckFunctionalityResourceExistsWithAttributes_withoutAutoAddRespondingTeam("firehydrant_functionality.test_functionality"),
 					resource.TestCheckResourceAttrSet("firehydrant_functionality.test_functionality", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("test-functionality-%s", rName)),
+						"firehydrant_functionality.test_functionality", "name", fmt.Sprintf("tf-acc-functionality-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "auto_add_responding_team", "false"),
 				),
 			},
@@ -303,7 +303,7 @@ func testAccCheckFunctionalityResourceDestroy() resource.TestCheckFunc {
 func testAccFunctionalityResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_functionality" "test_functionality" {
-  name = "test-functionality-%s"
+  name = "tf-acc-functionality-%s"
   labels = {
     test1 = "test-label1-foo",
   }
@@ -313,27 +313,27 @@ resource "firehydrant_functionality" "test_functionality" {
 func testAccFunctionalityResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service1" {
-  name = "test-service1-%s"
+  name = "tf-acc-service1-%s"
 }
 
 resource "firehydrant_service" "test_service2" {
-  name = "test-service2-%s"
+  name = "tf-acc-service2-%s"
 }
 
 resource "firehydrant_team" "test_team1" {
-  name = "test-team1-%s"
+  name = "tf-acc-team1-%s"
 }
 
 resource "firehydrant_team" "test_team2" {
-  name = "test-team2-%s"
+  name = "tf-acc-team2-%s"
 }
 
 resource "firehydrant_team" "test_team3" {
-  name = "test-team3-%s"
+  name = "tf-acc-team3-%s"
 }
 
 resource "firehydrant_functionality" "test_functionality" {
-  name        = "test-functionality-%s"
+  name        = "tf-acc-functionality-%s"
   description = "test-description-%s"
   labels = {
     test1 = "test-label1-foo",
@@ -356,7 +356,7 @@ resource "firehydrant_functionality" "test_functionality" {
 func testAccFunctionalityResourceConfig_withoutAutoAddRespondingTeam(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_functionality" "test_functionality" {
-  name = "test-functionality-%s"
+  name = "tf-acc-functionality-%s"
   labels = {
     test1 = "test-label1-foo",
   }
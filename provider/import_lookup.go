@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importLookupPerPage is the page size used when listing resources to resolve an import
+const importLookupPerPage = 100
+
+// importCandidate is a resource returned by a list endpoint that an import by name or slug
+// may resolve to
+type importCandidate struct {
+	ID   string
+	Name string
+	Slug string
+}
+
+// importLookup resolves import IDs of the form name=<name> or slug=<slug> to the
+// resource's opaque ID, so users don't have to dig IDs out of the UI or API.
+// Any other import ID is passed through unchanged.
+type importLookup struct {
+	// resourceName is used in error messages, e.g. "service"
+	resourceName string
+	// fields are the attributes the resource can be imported by
+	fields []string
+	// slugIsID is set for resources whose ID already is their slug (e.g. priorities)
+	slugIsID bool
+	// list returns every resource that may match the given field and value. Implementations
+	// should narrow the results server side where the API allows it; exact matching
+	// happens in resolve.
+	list func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error)
+}
+
+// importState can be used as the StateContext of a resource's Importer
+func (l importLookup) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id, err := l.resolve(ctx, m.(*firehydrant.APIClient), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resolve returns the ID of the single resource matching the import ID
+func (l importLookup) resolve(ctx context.Context, client *firehydrant.APIClient, id string) (string, error) {
+	field, value, ok := parseImportLookup(id)
+	if !ok {
+		return id, nil
+	}
+
+	supported := false
+	formats := make([]string, 0, len(l.fields))
+	for _, f := range l.fields {
+		supported = supported || f == field
+		formats = append(formats, fmt.Sprintf("%s=<%s>", f, f))
+	}
+	if !supported {
+		return "", fmt.Errorf("a %s can't be imported by %s, import it by ID or %s instead", l.resourceName, field, strings.Join(formats, " or "))
+	}
+	if l.slugIsID && field == "slug" {
+		return value, nil
+	}
+
+	candidates, err := l.list(ctx, client, field, value)
+	if err != nil {
+		return "", fmt.Errorf("could not look up %s with %s %q: %w", l.resourceName, field, value, err)
+	}
+	return matchImportCandidate(l.resourceName, field, value, candidates)
+}
+
+// parseImportLookup splits an import ID such as name=Payments into its field and value
+func parseImportLookup(id string) (string, string, bool) {
+	field, value, ok := strings.Cut(id, "=")
+	if !ok || value == "" {
+		return "", "", false
+	}
+
+	switch field {
+	case "name", "slug":
+		return field, value, true
+	default:
+		return "", "", false
+	}
+}
+
+// matchImportCandidate returns the ID of the only candidate whose field exactly matches value.
+// Finding none or more than one is an error, since importing the wrong resource is much
+// worse than asking the user to import by ID instead.
+func matchImportCandidate(resourceName, field, value string, candidates []importCandidate) (string, error) {
+	var matches []string
+	for _, c := range candidates {
+		got := c.Name
+		if field == "slug" {
+			got = c.Slug
+		}
+		if got == value {
+			matches = append(matches, c.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s found with %s %q", resourceName, field, value)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%s %q matches more than one %s (%s), import by ID instead", field, value, resourceName, strings.Join(matches, ", "))
+	}
+}
+
+// listImportCandidates pages through an SDK list endpoint and converts every entity to an importCandidate
+func listImportCandidates[TRequest any, TEntity any](
+	ctx context.Context,
+	client *firehydrant.APIClient,
+	request *TRequest,
+	setPage func(request *TRequest, page *int),
+	getPage func(ctx context.Context, request *TRequest) (pagination.PaginateResponse[TEntity], error),
+	toCandidate func(entity TEntity) importCandidate,
+) ([]importCandidate, error) {
+	entities, diags := pagination.Paginate(ctx, pagination.PaginateRequestOptions[TRequest, TEntity]{
+		Client:             client,
+		Request:            request,
+		SetRequestPageFunc: setPage,
+		GetPageFunc: func(ctx context.Context, _ *firehydrant.APIClient, request *TRequest) (pagination.PaginateResponse[TEntity], diag.Diagnostics) {
+			response, err := getPage(ctx, request)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			return response, nil
+		},
+	})
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	candidates := make([]importCandidate, 0, len(entities))
+	for _, entity := range entities {
+		candidates = append(candidates, toCandidate(entity))
+	}
+	return candidates, nil
+}
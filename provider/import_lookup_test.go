@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+)
+
+func TestParseImportLookup(t *testing.T) {
+	tests := []struct {
+		id            string
+		expectedField string
+		expectedValue string
+		expectedOk    bool
+	}{
+		{id: "name=Payments API", expectedField: "name", expectedValue: "Payments API", expectedOk: true},
+		{id: "slug=payments-api", expectedField: "slug", expectedValue: "payments-api", expectedOk: true},
+		{id: "name=a=b", expectedField: "name", expectedValue: "a=b", expectedOk: true},
+		{id: "name=", expectedOk: false},
+		{id: "email=someone@example.com", expectedOk: false},
+		{id: "00000000-0000-4000-8000-000000000000", expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			field, value, ok := parseImportLookup(tt.id)
+			if field != tt.expectedField || value != tt.expectedValue || ok != tt.expectedOk {
+				t.Fatalf("Expected (%q, %q, %t), got (%q, %q, %t)", tt.expectedField, tt.expectedValue, tt.expectedOk, field, value, ok)
+			}
+		})
+	}
+}
+
+func TestMatchImportCandidate(t *testing.T) {
+	candidates := []importCandidate{
+		{ID: "1", Name: "Payments", Slug: "payments"},
+		{ID: "2", Name: "Payments API", Slug: "payments-api"},
+		{ID: "3", Name: "Payments API", Slug: "payments-api-2"},
+	}
+
+	id, err := matchImportCandidate("service", "name", "Payments", candidates)
+	if err != nil || id != "1" {
+		t.Fatalf("Expected ID 1, got %q (error: %v)", id, err)
+	}
+
+	id, err = matchImportCandidate("service", "slug", "payments-api-2", candidates)
+	if err != nil || id != "3" {
+		t.Fatalf("Expected ID 3, got %q (error: %v)", id, err)
+	}
+
+	_, err = matchImportCandidate("service", "name", "Payments API", candidates)
+	if err == nil || !strings.Contains(err.Error(), "2, 3") {
+		t.Fatalf("Expected ambiguous match error listing both IDs, got: %v", err)
+	}
+
+	_, err = matchImportCandidate("service", "name", "payments", candidates)
+	if err == nil || !strings.Contains(err.Error(), "no service found") {
+		t.Fatalf("Expected no match error, got: %v", err)
+	}
+}
+
+func TestImportLookupResolve(t *testing.T) {
+	var requests []string
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"data":[{"id":"service-2","name":"Checkout","slug":"checkout"}],"pagination":{"page":2}}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"service-1","name":"Checkout Worker","slug":"checkout-worker"}],"pagination":{"page":1,"next":2}}`))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	client, err := firehydrant.NewRestClient("test-token-very-authorized", firehydrant.WithBaseURL(ts.URL+"/v1/"))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	id, err := serviceImportLookup.resolve(context.Background(), client, "name=Checkout")
+	if err != nil {
+		t.Fatalf("Received error resolving import ID: %s", err.Error())
+	}
+	if expected := "service-2"; expected != id {
+		t.Fatalf("Expected ID %s, got: %s", expected, id)
+	}
+	if expected, got := 2, len(requests); expected != got {
+		t.Fatalf("Expected %d list requests, got %d: %v", expected, got, requests)
+	}
+	if !strings.Contains(requests[0], "name=Checkout") {
+		t.Fatalf("Expected the name to be sent as a filter, got: %s", requests[0])
+	}
+
+	requests = nil
+	id, err = serviceImportLookup.resolve(context.Background(), client, "service-1")
+	if err != nil || id != "service-1" || len(requests) != 0 {
+		t.Fatalf("Expected plain IDs to pass through without requests, got %q (error: %v, requests: %v)", id, err, requests)
+	}
+
+	id, err = severityImportLookup.resolve(context.Background(), client, "slug=SEV1")
+	if err != nil || id != "SEV1" {
+		t.Fatalf("Expected severity slug to be used as the ID, got %q (error: %v)", id, err)
+	}
+
+	_, err = severityImportLookup.resolve(context.Background(), client, "name=SEV1")
+	if expected := "a severity can't be imported by name"; err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Expected unsupported lookup error, got: %v", err)
+	}
+}
+
+func TestImportLookupResolveByName(t *testing.T) {
+	responses := map[string]string{
+		"/v1/status_update_templates":                   `{"data":[{"id":"template-1","name":"Investigating"}],"pagination":{"page":1}}`,
+		"/v1/lifecycles/phases":                         `{"data":[{"id":"phase-1","milestones":[{"id":"milestone-1","name":"Started","slug":"started"}]},{"id":"phase-2","milestones":[{"id":"milestone-2","name":"Resolved","slug":"resolved"}]}]}`,
+		"/v1/signals/transposers":                       `{"data":[{"name":"Custom Alerts","slug":"custom-alerts"}],"pagination":{"page":1}}`,
+		"/v1/signals/email_targets":                     `{"data":[{"id":"email-1","name":"Support","slug":"support"}]}`,
+		"/v1/teams/team-1/on_call_schedules/schedule-1": `{"id":"schedule-1","rotations":[{"id":"rotation-1","name":"Primary"},{"id":"rotation-2","name":"Secondary"}]}`,
+		"/v1/services/service-1/dependencies":           `{"child_service_dependencies":[{"id":"dependency-1","service":{"name":"Checkout","slug":"checkout"}}],"parent_service_dependencies":[{"id":"dependency-2","service":{"name":"Database","slug":"database"}}]}`,
+	}
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		response, ok := responses[req.URL.Path]
+		if !ok {
+			t.Errorf("Unexpected request: %s", req.URL.RequestURI())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	client, err := firehydrant.NewRestClient("test-token-very-authorized", firehydrant.WithBaseURL(ts.URL+"/v1/"))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+	}
+
+	tests := []struct {
+		name     string
+		lookup   importLookup
+		id       string
+		expected string
+	}{
+		{name: "status update template", lookup: statusUpdateTemplateImportLookup, id: "name=Investigating", expected: "template-1"},
+		{name: "lifecycle milestone", lookup: lifecycleMilestoneImportLookup, id: "slug=resolved", expected: "milestone-2"},
+		{name: "custom event source", lookup: customEventSourceImportLookup, id: "name=Custom Alerts", expected: "custom-alerts"},
+		{name: "inbound email", lookup: inboundEmailImportLookup, id: "name=Support", expected: "email-1"},
+		{name: "rotation", lookup: rotationImportLookup("team-1", "schedule-1"), id: "name=Secondary", expected: "rotation-2"},
+		{name: "downstream service dependency", lookup: serviceDependencyImportLookup("service-1"), id: "slug=checkout", expected: "dependency-1"},
+		{name: "upstream service dependency", lookup: serviceDependencyImportLookup("service-1"), id: "name=Database", expected: "dependency-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.lookup.resolve(context.Background(), client, tt.id)
+			if err != nil {
+				t.Fatalf("Received error resolving import ID: %s", err.Error())
+			}
+			if tt.expected != id {
+				t.Fatalf("Expected ID %s, got: %s", tt.expected, id)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/google/cel-go/cel"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,8 +18,9 @@ func resourceInboundEmail() *schema.Resource {
 		ReadContext:   resourceInboundEmailRead,
 		UpdateContext: resourceInboundEmailUpdate,
 		DeleteContext: resourceInboundEmailDelete,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: inboundEmailImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,12 +36,14 @@ func resourceInboundEmail() *schema.Resource {
 				Optional: true,
 			},
 			"status_cel": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCELExpression(inboundEmailCELEnv, cel.StringType),
 			},
 			"level_cel": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCELExpression(inboundEmailCELEnv, cel.StringType),
 			},
 			"allowed_senders": {
 				Type:     schema.TypeSet,
@@ -143,6 +147,13 @@ func resourceInboundEmailRead(ctx context.Context, d *schema.ResourceData, m int
 
 	inboundEmail, err := client.Sdk.Signals.GetSignalsEmailTarget(ctx, d.Id())
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Inbound email %s no longer exists", d.Id()), map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -245,6 +256,9 @@ func resourceInboundEmailDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	err := client.Sdk.Signals.DeleteSignalsEmailTarget(ctx, d.Id())
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			return nil
+		}
 		return diag.Errorf("Error deleting inbound email %s: %v", d.Id(), err)
 	}
 
@@ -302,3 +316,27 @@ func flattenTarget(target *components.NullableSignalsAPITargetEntity) map[string
 		"id":   *id,
 	}
 }
+
+var inboundEmailImportLookup = importLookup{
+	resourceName: "inbound email",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		var query *string
+		if field == "name" {
+			query = &value
+		}
+		targets, err := client.Sdk.Signals.ListSignalsEmailTargets(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]importCandidate, 0, len(targets.GetData()))
+		for _, target := range targets.GetData() {
+			candidates = append(candidates, importCandidate{
+				ID:   stringValue(target.GetID()),
+				Name: stringValue(target.GetName()),
+				Slug: stringValue(target.GetSlug()),
+			})
+		}
+		return candidates, nil
+	},
+}
@@ -25,16 +25,16 @@ func TestAccInboundEmailResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckInboundEmailResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckInboundEmailResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccInboundEmailResourceConfig_basic(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInboundEmailResourceExists("firehydrant_inbound_email.test"),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("test-inbound-email-%s", rName)),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "slug", fmt.Sprintf("test-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("tf-acc-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "slug", fmt.Sprintf("tf-acc-inbound-email-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "description", "Test inbound email description"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "status_cel", "email.body.contains('has recovered') ? 'CLOSED' : 'OPEN'"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "level_cel", "email.body.contains('panic') ? 'ERROR' : 'INFO'"),
@@ -58,15 +58,15 @@ func TestAccInboundEmailResource_update(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckInboundEmailResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckInboundEmailResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccInboundEmailResourceConfig_basic(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInboundEmailResourceExists("firehydrant_inbound_email.test"),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("test-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("tf-acc-inbound-email-%s", rName)),
 					testAccCheckInboundEmailResourceEmailAddressFormat("firehydrant_inbound_email.test"),
 				),
 			},
@@ -75,7 +75,7 @@ func TestAccInboundEmailResource_update(t *testing.T) {
 				Config:    testAccInboundEmailResourceConfig_update(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInboundEmailResourceExists("firehydrant_inbound_email.test"),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("updated-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("tf-acc-updated-inbound-email-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "description", "Updated test inbound email description"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "allowed_senders.#", "2"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "rules.#", "2"),
@@ -95,15 +95,15 @@ func TestAccInboundEmailResource_no_target(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckInboundEmailResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckInboundEmailResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccInboundResourceConfig_no_target(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInboundEmailResourceExists("firehydrant_inbound_email.test"),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("test-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("tf-acc-inbound-email-%s", rName)),
 					resource.TestCheckNoResourceAttr("firehydrant_inbound_email.test", "target.0"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "rules.#", "0"),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "rule_matching_strategy", "all"),
@@ -115,7 +115,7 @@ func TestAccInboundEmailResource_no_target(t *testing.T) {
 				Config:    testAccInboundEmailResourceConfig_basic(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInboundEmailResourceExists("firehydrant_inbound_email.test"),
-					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("test-inbound-email-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "name", fmt.Sprintf("tf-acc-inbound-email-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_inbound_email.test", "target.0.type", "Team"),
 					resource.TestCheckResourceAttrSet("firehydrant_inbound_email.test", "target.0.id"),
 				),
@@ -197,8 +197,8 @@ func testAccCheckInboundEmailResourceEmailAddressFormat(resourceName string) res
 func testAccInboundEmailResourceConfig_basic(rName, sharedTeamID string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_inbound_email" "test" {
-  name                   = "test-inbound-email-%s"
-  slug                   = "test-inbound-email-%s"
+  name                   = "tf-acc-inbound-email-%s"
+  slug                   = "tf-acc-inbound-email-%s"
   description            = "Test inbound email description"
   status_cel             = "email.body.contains('has recovered') ? 'CLOSED' : 'OPEN'"
   level_cel              = "email.body.contains('panic') ? 'ERROR' : 'INFO'"
@@ -216,8 +216,8 @@ resource "firehydrant_inbound_email" "test" {
 func testAccInboundEmailResourceConfig_update(rName, sharedTeamID string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_inbound_email" "test" {
-  name                   = "updated-inbound-email-%s"
-  slug                   = "test-inbound-email-%s"
+  name                   = "tf-acc-updated-inbound-email-%s"
+  slug                   = "tf-acc-inbound-email-%s"
   description            = "Updated test inbound email description"
   status_cel             = "email.body.contains('resolved') ? 'CLOSED' : 'OPEN'"
   level_cel              = "email.body.contains('critical') ? 'ERROR' : 'INFO'"
@@ -235,12 +235,12 @@ resource "firehydrant_inbound_email" "test" {
 func testAccInboundResourceConfig_no_target(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test" {
-  name = "test-team-%s"
+  name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_inbound_email" "test" {
-  name            = "test-inbound-email-%s"
-  slug            = "test-inbound-email-%s"
+  name            = "tf-acc-inbound-email-%s"
+  slug            = "tf-acc-inbound-email-%s"
   description     = "Test inbound email without target"
   status_cel      = "email.body.contains('resolved') ? 'CLOSED' : 'OPEN'"
   level_cel       = "email.body.contains('critical') ? 'ERROR' : 'INFO'"
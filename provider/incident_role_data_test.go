@@ -13,15 +13,15 @@ func TestAccIncidentRoleDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rName)),
+						"data.firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_incident_role.test_incident_role", "summary", fmt.Sprintf("test-summary-%s", rName)),
 				),
@@ -35,15 +35,15 @@ func TestAccIncidentRoleDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rName)),
+						"data.firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_incident_role.test_incident_role", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr(
@@ -57,7 +57,7 @@ func TestAccIncidentRoleDataSource_allAttributes(t *testing.T) {
 func testAccIncidentRoleDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_role" "test_incident_role" {
-  name    = "test-incident-role-%s"
+  name    = "tf-acc-incident-role-%s"
   summary = "test-summary-%s"
 }
 
@@ -69,7 +69,7 @@ data "firehydrant_incident_role" "test_incident_role" {
 func testAccIncidentRoleDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_role" "test_incident_role" {
-  name        = "test-incident-role-%s"
+  name        = "tf-acc-incident-role-%s"
   description = "test-description-%s"
   summary     = "test-summary-%s"
 }
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +20,9 @@ func resourceIncidentRole() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantIncidentRole,
 		ReadContext:   readResourceFireHydrantIncidentRole,
 		DeleteContext: deleteResourceFireHydrantIncidentRole,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: incidentRoleImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -53,7 +55,7 @@ func readResourceFireHydrantIncidentRole(ctx context.Context, d *schema.Resource
 	})
 	incidentRole, err := client.Sdk.IncidentSettings.GetIncidentRole(ctx, incidentRoleID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Incident role %s no longer exists", incidentRoleID), map[string]interface{}{
 				"id": incidentRoleID,
 			})
@@ -167,7 +169,7 @@ func deleteResourceFireHydrantIncidentRole(ctx context.Context, d *schema.Resour
 	})
 	err := client.Sdk.IncidentSettings.DeleteIncidentRole(ctx, incidentRoleID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting incident role %s: %v", incidentRoleID, err)
@@ -175,3 +177,20 @@ func deleteResourceFireHydrantIncidentRole(ctx context.Context, d *schema.Resour
 
 	return diag.Diagnostics{}
 }
+
+var incidentRoleImportLookup = importLookup{
+	resourceName: "incident role",
+	fields:       []string{"name"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListIncidentRolesRequest{PerPage: ptr.Of(importLookupPerPage)}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListIncidentRolesRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListIncidentRolesRequest) (pagination.PaginateResponse[components.IncidentRoleEntity], error) {
+				return client.Sdk.IncidentSettings.ListIncidentRoles(ctx, request.Page, request.PerPage)
+			},
+			func(incidentRole components.IncidentRoleEntity) importCandidate {
+				return importCandidate{ID: stringValue(incidentRole.GetID()), Name: stringValue(incidentRole.GetName())}
+			},
+		)
+	},
+}
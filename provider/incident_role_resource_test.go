@@ -15,9 +15,9 @@ func TestAccIncidentRoleResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckIncidentRoleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckIncidentRoleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleResourceConfig_basic(rName),
@@ -25,7 +25,7 @@ func TestAccIncidentRoleResource_basic(t *testing.T) {
 					testAccCheckIncidentRoleResourceExistsWithAttributes_basic("firehydrant_incident_role.test_incident_role"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rName)),
+						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_role.test_incident_role", "summary", fmt.Sprintf("test-summary-%s", rName)),
 					resource.TestCheckResourceAttr(
@@ -42,9 +42,9 @@ func TestAccIncidentRoleResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckIncidentRoleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckIncidentRoleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleResourceConfig_basic(rName),
@@ -52,7 +52,7 @@ func TestAccIncidentRoleResource_update(t *testing.T) {
 					testAccCheckIncidentRoleResourceExistsWithAttributes_basic("firehydrant_incident_role.test_incident_role"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rName)),
+						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_role.test_incident_role", "summary", fmt.Sprintf("test-summary-%s", rName)),
 					resource.TestCheckResourceAttr(
@@ -65,7 +65,7 @@ func TestAccIncidentRoleResource_update(t *testing.T) {
 					testAccCheckIncidentRoleResourceExistsWithAttributes_update("firehydrant_incident_role.test_incident_role"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rNameUpdated)),
+						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_role.test_incident_role", "summary", fmt.Sprintf("test-summary-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
@@ -78,7 +78,7 @@ func TestAccIncidentRoleResource_update(t *testing.T) {
 					testAccCheckIncidentRoleResourceExistsWithAttributes_basic("firehydrant_incident_role.test_incident_role"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_role.test_incident_role", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("test-incident-role-%s", rNameUpdated)),
+						"firehydrant_incident_role.test_incident_role", "name", fmt.Sprintf("tf-acc-incident-role-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_role.test_incident_role", "summary", fmt.Sprintf("test-summary-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
@@ -94,8 +94,8 @@ func TestAccIncidentRoleResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleResourceConfig_basic(rName),
@@ -114,8 +114,8 @@ func TestAccIncidentRoleResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentRoleResourceConfig_update(rName),
@@ -240,7 +240,7 @@ func testAccCheckIncidentRoleResourceDestroy() resource.TestCheckFunc {
 func testAccIncidentRoleResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_role" "test_incident_role" {
-  name        = "test-incident-role-%s"
+  name        = "tf-acc-incident-role-%s"
   summary     = "test-summary-%s"
   description = "test-description-%s"
 }`, rName, rName, rName)
@@ -249,7 +249,7 @@ resource "firehydrant_incident_role" "test_incident_role" {
 func testAccIncidentRoleResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_role" "test_incident_role" {
-  name        = "test-incident-role-%s"
+  name        = "tf-acc-incident-role-%s"
   summary     = "test-summary-%s"
   description = "test-description-%s"
 }`, rName, rName, rName)
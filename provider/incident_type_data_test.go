@@ -13,16 +13,16 @@ func TestAccIncidentTypeDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckIncidentTypeResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckIncidentTypeResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentTypeDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rName)),
+						"data.firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rName)),
 				),
@@ -36,8 +36,8 @@ func TestAccIncidentTypeDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckIncidentTypeResourceDestroy(),
 			testAccCheckTeamResourceDestroy(),
@@ -48,7 +48,7 @@ func TestAccIncidentTypeDataSource_allAttributes(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rName)),
+						"data.firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr(
@@ -80,7 +80,7 @@ func TestAccIncidentTypeDataSource_allAttributes(t *testing.T) {
 func testAccIncidentTypeDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_type" "test_incident_type" {
-  name        = "test-incident-type-%s"
+  name        = "tf-acc-incident-type-%s"
   description = "test-description-%s"
 
 	template {}
@@ -100,11 +100,11 @@ func testAccIncidentTypeDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 
 resource "firehydrant_team" "test_team_1" {
-  name = "test-team-1-%s"
+  name = "tf-acc-team-1-%s"
 }
 
 resource "firehydrant_team" "test_team_2" {
-  name = "test-team-2-%s"
+  name = "tf-acc-team-2-%s"
 }
 
 
@@ -116,7 +116,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook_1" {
-  name = "test-runbook-1-%s"
+  name = "tf-acc-runbook-1-%s"
 
   steps {
     name      = "Create Incident Channel"
@@ -129,15 +129,15 @@ resource "firehydrant_runbook" "test_runbook_1" {
 }
 
 resource "firehydrant_service" "test_service_1" {
-  name = "test-service-1-%s"
+  name = "tf-acc-service-1-%s"
 }
 
 resource "firehydrant_service" "test_service_2" {
-  name = "test-service-2-%s"
+  name = "tf-acc-service-2-%s"
 }
 
 resource "firehydrant_incident_type" "test_incident_type" {
-  name        = "test-incident-type-%s"
+  name        = "tf-acc-incident-type-%s"
   description = "test-description-%s"
 	template {
 	  description = "test-template-description"
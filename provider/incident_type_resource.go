@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +20,9 @@ func resourceIncidentType() *schema.Resource {
 		ReadContext:   readResourceIncidentType,
 		UpdateContext: updateResourceIncidentType,
 		DeleteContext: deleteResourceIncidentType,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: incidentTypeImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -187,6 +190,13 @@ func readResourceIncidentType(ctx context.Context, d *schema.ResourceData, m int
 
 	response, err := client.Sdk.IncidentSettings.GetIncidentType(ctx, id)
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Incident type %s no longer exists", id), map[string]interface{}{
+				"id": id,
+			})
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -328,7 +338,7 @@ func deleteResourceIncidentType(ctx context.Context, d *schema.ResourceData, m i
 	})
 	err := client.Sdk.IncidentSettings.DeleteIncidentType(ctx, id)
 	if err != nil {
-		if err.(*sdkerrors.SDKError).StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -337,3 +347,20 @@ func deleteResourceIncidentType(ctx context.Context, d *schema.ResourceData, m i
 
 	return diag.Diagnostics{}
 }
+
+var incidentTypeImportLookup = importLookup{
+	resourceName: "incident type",
+	fields:       []string{"name"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListIncidentTypesRequest{Query: &value, PerPage: ptr.Of(importLookupPerPage)}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListIncidentTypesRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListIncidentTypesRequest) (pagination.PaginateResponse[components.IncidentTypeEntity], error) {
+				return client.Sdk.IncidentSettings.ListIncidentTypes(ctx, request.Query, request.Page, request.PerPage)
+			},
+			func(incidentType components.IncidentTypeEntity) importCandidate {
+				return importCandidate{ID: stringValue(incidentType.GetID()), Name: stringValue(incidentType.GetName())}
+			},
+		)
+	},
+}
@@ -15,9 +15,9 @@ func TestAccIncidentTypeResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckIncidentTypeResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckIncidentTypeResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentTypeResourceConfig_basic(rName),
@@ -25,7 +25,7 @@ func TestAccIncidentTypeResource_basic(t *testing.T) {
 					testAccCheckIncidentTypeResourceExistsWithAttributes_basic("firehydrant_incident_type.test_incident_type"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rName)),
+						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rName)),
 				),
@@ -40,8 +40,8 @@ func TestAccIncidentTypeResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckIncidentTypeResourceDestroy(),
 			testAccCheckTeamResourceDestroy(),
@@ -53,7 +53,7 @@ func TestAccIncidentTypeResource_update(t *testing.T) {
 					testAccCheckIncidentTypeResourceExistsWithAttributes_basic("firehydrant_incident_type.test_incident_type"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rName)),
+						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rName)),
 				),
@@ -64,7 +64,7 @@ func TestAccIncidentTypeResource_update(t *testing.T) {
 					testAccCheckIncidentTypeResourceExistsWithAttributes_basic("firehydrant_incident_type.test_incident_type"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rNameUpdated)),
+						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 				),
@@ -75,7 +75,7 @@ func TestAccIncidentTypeResource_update(t *testing.T) {
 					testAccCheckIncidentTypeResourceExistsWithAttributes_update("firehydrant_incident_type.test_incident_type"),
 					resource.TestCheckResourceAttrSet("firehydrant_incident_type.test_incident_type", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("test-incident-type-%s", rNameUpdated)),
+						"firehydrant_incident_type.test_incident_type", "name", fmt.Sprintf("tf-acc-incident-type-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_incident_type.test_incident_type", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
@@ -109,8 +109,8 @@ func TestAccIncidentTypeResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentTypeResourceConfig_basic(rName),
@@ -129,8 +129,8 @@ func TestAccIncidentTypeResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIncidentTypeResourceConfig_update(rName),
@@ -241,7 +241,7 @@ func testAccCheckIncidentTypeResourceDestroy() resource.TestCheckFunc {
 func testAccIncidentTypeResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_type" "test_incident_type" {
-  name        = "test-incident-type-%s"
+  name        = "tf-acc-incident-type-%s"
   description = "test-description-%s"
 
 	template {}
@@ -251,11 +251,11 @@ resource "firehydrant_incident_type" "test_incident_type" {
 func testAccIncidentTypeResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team_1" {
-  name = "test-team-1-%s"
+  name = "tf-acc-team-1-%s"
 }
 
 resource "firehydrant_team" "test_team_2" {
-  name = "test-team-2-%s"
+  name = "tf-acc-team-2-%s"
 }
 
 
@@ -266,7 +266,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook_1" {
-  name = "test-runbook-1-%s"
+  name = "tf-acc-runbook-1-%s"
 
   steps {
     name      = "Create Incident Channel"
@@ -279,15 +279,15 @@ resource "firehydrant_runbook" "test_runbook_1" {
 }
 
 resource "firehydrant_service" "test_service_1" {
-  name = "test-service-1-%s"
+  name = "tf-acc-service-1-%s"
 }
 
 resource "firehydrant_service" "test_service_2" {
-  name = "test-service-2-%s"
+  name = "tf-acc-service-2-%s"
 }
 
 resource "firehydrant_incident_type" "test_incident_type" {
-  name        = "test-incident-type-%s"
+  name        = "tf-acc-incident-type-%s"
   description = "test-description-%s"
 	template {
 	  description = "test-template-description"
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,58 +53,86 @@ func dataSourceIngestURL() *schema.Resource {
 func dataFireHydrantIngestURL(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	firehydrantAPIClient := m.(firehydrant.Client)
 
-	team_id := d.Get("team_id").(string)
-	user_id := d.Get("user_id").(string)
-	escalation_policy_id := d.Get("escalation_policy_id").(string)
-	schedule_id := d.Get("on_call_schedule_id").(string)
-	transposer := d.Get("transposer").(string)
+	lookup := ingestURLLookup{
+		TeamID:             d.Get("team_id").(string),
+		UserID:             d.Get("user_id").(string),
+		EscalationPolicyID: d.Get("escalation_policy_id").(string),
+		OnCallScheduleID:   d.Get("on_call_schedule_id").(string),
+		Transposer:         d.Get("transposer").(string),
+	}
+	ingestURL, err := lookup.get(ctx, firehydrantAPIClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set the ID
+	if err := d.Set("url", ingestURL); err != nil {
+		return diag.Errorf("Error setting url: %v", err)
+	}
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 
-	if team_id == "" && (escalation_policy_id != "" || schedule_id != "") {
-		return diag.Errorf("`team_id` must be set if either `escalation_policy_id` or `on_call_schedule_id` is set")
+	return diag.Diagnostics{}
+}
+
+// ingestURLLookup finds the ingest URL of a team, user, escalation policy or on-call schedule,
+// optionally for a transposer. It is shared by the firehydrant_ingest_url data source and
+// ephemeral resource.
+type ingestURLLookup struct {
+	TeamID             string
+	UserID             string
+	EscalationPolicyID string
+	OnCallScheduleID   string
+	Transposer         string
+}
+
+func (l ingestURLLookup) get(ctx context.Context, firehydrantAPIClient firehydrant.Client) (string, error) {
+	if l.TeamID == "" && (l.EscalationPolicyID != "" || l.OnCallScheduleID != "") {
+		return "", fmt.Errorf("`team_id` must be set if either `escalation_policy_id` or `on_call_schedule_id` is set")
 	}
 
-	ingestURL := ""
-	if transposer == "" {
+	if l.Transposer == "" {
 		// If no transposer is requested, we use the ingest URL API endpoint.  Otherwise, we use the transposers endpoint.
 		params := firehydrant.IngestURLParams{
-			TeamID:             team_id,
-			UserID:             user_id,
-			EscalationPolicyID: escalation_policy_id,
-			OnCallScheduleID:   schedule_id,
+			TeamID:             l.TeamID,
+			UserID:             l.UserID,
+			EscalationPolicyID: l.EscalationPolicyID,
+			OnCallScheduleID:   l.OnCallScheduleID,
 		}
 
 		url, err := firehydrantAPIClient.IngestURL().Get(ctx, params)
 		if err != nil {
-			return diag.FromErr(err)
-		}
-		ingestURL = url.URL
-	} else {
-		params := firehydrant.TransposersParams{
-			TeamID:             team_id,
-			UserID:             user_id,
-			EscalationPolicyID: escalation_policy_id,
-			OnCallScheduleID:   schedule_id,
-		}
-
-		ts, err := firehydrantAPIClient.Transposers().Get(ctx, params)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		for _, t := range ts.Transposers {
-			if t.Slug == transposer {
-				ingestURL = t.IngestURL
-			}
-		}
-		if ingestURL == "" {
-			return diag.Errorf("No transposer found with slug %s", transposer)
+			return "", err
 		}
+		return url.URL, nil
 	}
 
-	// Set the ID
-	if err := d.Set("url", ingestURL); err != nil {
-		return diag.Errorf("Error setting url: %v", err)
+	params := firehydrant.TransposersParams{
+		TeamID:             l.TeamID,
+		UserID:             l.UserID,
+		EscalationPolicyID: l.EscalationPolicyID,
+		OnCallScheduleID:   l.OnCallScheduleID,
 	}
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 
-	return diag.Diagnostics{}
+	transposers, diags := pagination.Paginate(ctx, pagination.PaginateRequestOptions[firehydrant.TransposersParams, firehydrant.Transposer]{
+		Request: &params,
+		SetRequestPageFunc: func(params *firehydrant.TransposersParams, page *int) {
+			params.Page = *page
+		},
+		GetPageFunc: func(ctx context.Context, _ *firehydrant.APIClient, params *firehydrant.TransposersParams) (pagination.PaginateResponse[firehydrant.Transposer], diag.Diagnostics) {
+			response, err := firehydrantAPIClient.Transposers().Get(ctx, *params)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			return pagination.RESTPage[firehydrant.Transposer]{Data: response.Transposers, Pagination: response.Pagination}, nil
+		},
+	})
+	if diags.HasError() {
+		return "", fmt.Errorf("%s", diags[0].Summary)
+	}
+	for _, t := range transposers {
+		if t.Slug == l.Transposer {
+			return t.IngestURL, nil
+		}
+	}
+	return "", fmt.Errorf("No transposer found with slug %s", l.Transposer)
 }
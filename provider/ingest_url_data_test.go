@@ -12,11 +12,13 @@ import (
 
 func offlineIngestURLMockServer() *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(`{ "url":"https://signals.firehydrant.com/v1/process/some-long-jwt" }`))
 	}))
 }
 func offlineTransposerMockServer() *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(`{
 			"data":[
 				{"name": "Valid Transposer", "slug": "valid-transposer", "example_payload": "", "expression": "", "expected": "", 
@@ -130,3 +132,37 @@ func TestOfflineIngestURL_InvalidTransposer(t *testing.T) {
 		t.Fatalf("didnt fail on reading ingest URL: %v", d)
 	}
 }
+
+func TestOfflineIngestURL_TransposerOnLaterPage(t *testing.T) {
+	tts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") != "2" {
+			w.Write([]byte(`{
+				"data": [{"name": "Other Transposer", "slug": "other-transposer", "ingest_url": "https://signals.firehydrant.com/v1/transpose/other-transposer/some-long-jwt"}],
+				"pagination": {"count": 2, "page": 1, "items": 1, "pages": 2, "last": 2, "prev": null, "next": 2}
+			}`))
+			return
+		}
+		w.Write([]byte(`{
+			"data": [{"name": "Valid Transposer", "slug": "valid-transposer", "ingest_url": "https://signals.firehydrant.com/v1/transpose/valid-transposer/some-long-jwt"}],
+			"pagination": {"count": 2, "page": 2, "items": 1, "pages": 2, "last": 2, "prev": 1, "next": null}
+		}`))
+	}))
+	defer tts.Close()
+
+	c, err := firehydrant.NewRestClient("test-token-very-authorized", firehydrant.WithBaseURL(tts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+	r := schema.TestResourceDataRaw(t, dataSourceIngestURL().Schema, map[string]interface{}{
+		"transposer": "valid-transposer",
+	})
+
+	d := dataFireHydrantIngestURL(context.Background(), r, c)
+	if d.HasError() {
+		t.Fatalf("error reading ingest URL: %v", d)
+	}
+	if url := r.Get("url").(string); url != "https://signals.firehydrant.com/v1/transpose/valid-transposer/some-long-jwt" {
+		t.Fatalf("expected URL to be https://signals.firehydrant.com/v1/transpose/valid-transposer/some-long-jwt, got %s", url)
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ingestURLEphemeralResource looks up the same ingest URL as the firehydrant_ingest_url data
+// source. The URL authenticates whoever sends signals to it, so this keeps it out of plan
+// and state for configurations that only pass it on to write-only attributes.
+type ingestURLEphemeralResource struct {
+	client *firehydrant.APIClient
+}
+
+var (
+	_ ephemeral.EphemeralResource              = &ingestURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ingestURLEphemeralResource{}
+)
+
+func newIngestURLEphemeralResource() ephemeral.EphemeralResource {
+	return &ingestURLEphemeralResource{}
+}
+
+type ingestURLEphemeralResourceModel struct {
+	Transposer         types.String `tfsdk:"transposer"`
+	TeamID             types.String `tfsdk:"team_id"`
+	UserID             types.String `tfsdk:"user_id"`
+	EscalationPolicyID types.String `tfsdk:"escalation_policy_id"`
+	OnCallScheduleID   types.String `tfsdk:"on_call_schedule_id"`
+	URL                types.String `tfsdk:"url"`
+}
+
+func (r *ingestURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingest_url"
+}
+
+func (r *ingestURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"transposer": schema.StringAttribute{
+				Optional: true,
+			},
+			"team_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("user_id")),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("team_id")),
+				},
+			},
+			"escalation_policy_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"on_call_schedule_id": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Computed
+			"url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *ingestURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *ingestURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ingestURLEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup := ingestURLLookup{
+		TeamID:             model.TeamID.ValueString(),
+		UserID:             model.UserID.ValueString(),
+		EscalationPolicyID: model.EscalationPolicyID.ValueString(),
+		OnCallScheduleID:   model.OnCallScheduleID.ValueString(),
+		Transposer:         model.Transposer.ValueString(),
+	}
+	ingestURL, err := lookup.get(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ingest URL", err.Error())
+		return
+	}
+
+	model.URL = types.StringValue(ingestURL)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
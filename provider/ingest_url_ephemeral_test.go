@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOfflineIngestURLEphemeral(t *testing.T) {
+	tis := offlineIngestURLMockServer()
+	defer tis.Close()
+	tts := offlineTransposerMockServer()
+	defer tts.Close()
+
+	cases := []struct {
+		name    string
+		baseURL string
+		config  map[string]tftypes.Value
+		url     string
+		err     string
+	}{
+		{
+			name:    "user",
+			baseURL: tis.URL,
+			config: map[string]tftypes.Value{
+				"user_id": tftypes.NewValue(tftypes.String, "00000000-0000-4000-8000-000000000000"),
+			},
+			url: "https://signals.firehydrant.com/v1/process/some-long-jwt",
+		},
+		{
+			name:    "transposer",
+			baseURL: tts.URL,
+			config: map[string]tftypes.Value{
+				"user_id":    tftypes.NewValue(tftypes.String, "00000000-0000-4000-8000-000000000000"),
+				"transposer": tftypes.NewValue(tftypes.String, "valid-transposer"),
+			},
+			url: "https://signals.firehydrant.com/v1/transpose/valid-transposer/some-long-jwt",
+		},
+		{
+			name:    "unknown transposer",
+			baseURL: tts.URL,
+			config: map[string]tftypes.Value{
+				"user_id":    tftypes.NewValue(tftypes.String, "00000000-0000-4000-8000-000000000000"),
+				"transposer": tftypes.NewValue(tftypes.String, "invalid-transposer"),
+			},
+			err: "No transposer found with slug invalid-transposer",
+		},
+		{
+			name:    "schedule without team",
+			baseURL: tis.URL,
+			config: map[string]tftypes.Value{
+				"on_call_schedule_id": tftypes.NewValue(tftypes.String, "00000000-0000-4000-8000-000000000000"),
+			},
+			err: "`team_id` must be set",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, diags := testOpenEphemeralResource(t, c.baseURL, "firehydrant_ingest_url", c.config)
+			if c.err != "" {
+				if len(diags) == 0 || !strings.Contains(diags[0].Detail, c.err) {
+					t.Fatalf("expected an error containing %q, got %v", c.err, diags)
+				}
+				return
+			}
+			for _, d := range diags {
+				t.Fatalf("%s: %s", d.Summary, d.Detail)
+			}
+
+			var attributes map[string]tftypes.Value
+			if err := result.As(&attributes); err != nil {
+				t.Fatal(err)
+			}
+			var url string
+			if err := attributes["url"].As(&url); err != nil {
+				t.Fatal(err)
+			}
+			if url != c.url {
+				t.Fatalf("expected URL to be %s, got %s", c.url, url)
+			}
+		})
+	}
+}
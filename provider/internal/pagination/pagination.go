@@ -2,7 +2,7 @@ package pagination
 
 import (
 	"context"
-	"time"
+	"sync"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
@@ -10,18 +10,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
+// DefaultConcurrency is how many pages are fetched at once when Concurrency isn't set
+const DefaultConcurrency = 4
+
 // PaginateRequestOptions is the options for the Paginate function
 type PaginateRequestOptions[TRequest any, TEntity any] struct {
 	// Client is the API client to use for the pagination
 	Client *firehydrant.APIClient
-	// Request is the request to use for the pagination
+	// Request is the request to use for the pagination. Pages fetched concurrently each
+	// get a shallow copy of it.
 	Request *TRequest
 	// SetRequestPageFunc is the function to use to set the page on the request
 	SetRequestPageFunc func(request *TRequest, page *int)
 	// GetPageFunc is the function to use to get the page from the API
 	GetPageFunc func(ctx context.Context, client *firehydrant.APIClient, request *TRequest) (PaginateResponse[TEntity], diag.Diagnostics)
-	// GetPageDelay is an optional duration to sleep between pages to avoid rate limits
-	GetPageDelay time.Duration
+	// Concurrency is the most pages fetched at once after the first, DefaultConcurrency
+	// unless set. Set it to 1 to fetch pages one after another.
+	Concurrency int
+	// MaxItems stops the pagination once this many items were fetched, zero fetches all
+	MaxItems int
 }
 
 // PaginateResponse is an interface that the response from the API must implement.
@@ -31,35 +38,153 @@ type PaginateResponse[TEntity any] interface {
 	GetPagination() *components.NullablePaginationEntity
 }
 
-// Paginate is the function that will paginate the API response for SDK-based calls to the API
+// Paginate is the function that will paginate the API response for SDK-based calls to the API.
+// Once the first page tells how many pages there are, the rest are fetched concurrently and
+// returned in order. Every request goes through the client, so they share its rate limit and
+// retries.
 func Paginate[TRequest any, TEntity any](ctx context.Context, options PaginateRequestOptions[TRequest, TEntity]) ([]TEntity, diag.Diagnostics) {
-	results := []TEntity{}
-	page := ptr.Of(1)
+	response, diags := getPage(ctx, options, options.Request, 1)
+	if diags.HasError() {
+		return nil, diags
+	}
+	results := response.GetData()
+	if options.maxItemsReached(len(results)) {
+		return options.truncate(results), nil
+	}
 
-	for page != nil {
-		if options.GetPageDelay > 0 {
-			time.Sleep(options.GetPageDelay)
-		}
-		if options.SetRequestPageFunc != nil {
-			options.SetRequestPageFunc(options.Request, page)
+	next := response.GetPagination().GetNext()
+	if next == nil {
+		return results, nil
+	}
+
+	last := lastPage(response.GetPagination())
+	if last == 0 || options.concurrency() == 1 {
+		return paginateSequentially(ctx, options, results, next)
+	}
+	if options.MaxItems > 0 && len(results) > 0 {
+		// Every page but the last is as long as the first
+		last = min(last, (options.MaxItems+len(results)-1)/len(results))
+	}
+
+	pages, diags := paginateConcurrently(ctx, options, *next, last)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, page := range pages {
+		results = append(results, page...)
+	}
+	return options.truncate(results), nil
+}
+
+// paginateSequentially follows the next page of each response, for endpoints that don't
+// say how many pages there are
+func paginateSequentially[TRequest any, TEntity any](ctx context.Context, options PaginateRequestOptions[TRequest, TEntity], results []TEntity, page *int) ([]TEntity, diag.Diagnostics) {
+	for page != nil && !options.maxItemsReached(len(results)) {
+		response, diags := getPage(ctx, options, options.Request, *page)
+		if diags.HasError() {
+			return nil, diags
 		}
-		response, err := options.GetPageFunc(ctx, options.Client, options.Request)
-		if err != nil {
-			return nil, err
+		results = append(results, response.GetData()...)
+		page = response.GetPagination().GetNext()
+	}
+	return options.truncate(results), nil
+}
+
+// paginateConcurrently fetches the pages from first to last with at most Concurrency
+// requests in flight, returning their data in page order. The first error cancels the
+// pages still in flight.
+func paginateConcurrently[TRequest any, TEntity any](ctx context.Context, options PaginateRequestOptions[TRequest, TEntity], first, last int) ([][]TEntity, diag.Diagnostics) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		diags diag.Diagnostics
+	)
+	fail := func(d diag.Diagnostics) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !diags.HasError() {
+			diags = d
 		}
+		cancel()
+	}
 
-		if response == nil {
-			return nil, diag.Errorf("unexpected respsonse")
+	pages := make([][]TEntity, last-first+1)
+	inFlight := make(chan struct{}, options.concurrency())
+	for page := first; page <= last; page++ {
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			fail(diag.FromErr(err))
+			break
 		}
 
-		results = append(results, response.GetData()...)
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			defer func() { <-inFlight }()
 
-		if response.GetPagination() != nil {
-			page = response.GetPagination().GetNext()
-		} else {
-			page = nil
-		}
+			// Each page needs its own request, since setting the page modifies it
+			request := *options.Request
+			response, d := getPage(ctx, options, &request, page)
+			if d.HasError() {
+				fail(d)
+				return
+			}
+			pages[page-first] = response.GetData()
+		}(page)
+	}
+	wg.Wait()
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return pages, nil
+}
+
+func getPage[TRequest any, TEntity any](ctx context.Context, options PaginateRequestOptions[TRequest, TEntity], request *TRequest, page int) (PaginateResponse[TEntity], diag.Diagnostics) {
+	if options.SetRequestPageFunc != nil {
+		options.SetRequestPageFunc(request, ptr.Of(page))
+	}
+	response, err := options.GetPageFunc(ctx, options.Client, request)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, diag.Errorf("unexpected respsonse")
+	}
+	return response, nil
+}
+
+// lastPage returns the number of the last page, or zero when the response doesn't say
+func lastPage(pagination *components.NullablePaginationEntity) int {
+	if last := pagination.GetLast(); last != nil {
+		return *last
+	}
+	if pages := pagination.GetPages(); pages != nil {
+		return *pages
+	}
+	return 0
+}
+
+func (o PaginateRequestOptions[TRequest, TEntity]) concurrency() int {
+	if o.Concurrency <= 0 {
+		return DefaultConcurrency
 	}
-	return results, nil
+	return o.Concurrency
+}
 
+func (o PaginateRequestOptions[TRequest, TEntity]) maxItemsReached(n int) bool {
+	return o.MaxItems > 0 && n >= o.MaxItems
+}
+
+func (o PaginateRequestOptions[TRequest, TEntity]) truncate(results []TEntity) []TEntity {
+	if o.MaxItems > 0 && len(results) > o.MaxItems {
+		return results[:o.MaxItems]
+	}
+	return results
 }
@@ -3,7 +3,9 @@ package pagination
 import (
 	"context"
 	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
@@ -143,3 +145,186 @@ func TestPaginateRequest_ErrorOnSecondPage(t *testing.T) {
 		t.Errorf("expected 0 items, got %d", len(result))
 	}
 }
+
+// pagedRequestFunc serves pages of perPage items counting up from 1, saying how many pages
+// there are, and records how many pages are fetched at once
+func pagedRequestFunc(pages, perPage int, fetched *int32, inFlight, maxInFlight *int32) func(ctx context.Context, client *firehydrant.APIClient, request *testRequest) (PaginateResponse[int], diag.Diagnostics) {
+	return func(ctx context.Context, client *firehydrant.APIClient, request *testRequest) (PaginateResponse[int], diag.Diagnostics) {
+		atomic.AddInt32(fetched, 1)
+		n := atomic.AddInt32(inFlight, 1)
+		defer atomic.AddInt32(inFlight, -1)
+		for {
+			current := atomic.LoadInt32(maxInFlight)
+			if n <= current || atomic.CompareAndSwapInt32(maxInFlight, current, n) {
+				break
+			}
+		}
+		// Later pages are faster, so they finish out of order
+		page := *request.Page
+		time.Sleep(time.Duration(pages-page) * time.Millisecond)
+
+		items := make([]int, 0, perPage)
+		for i := 1; i <= perPage; i++ {
+			items = append(items, (page-1)*perPage+i)
+		}
+		pagination := &components.NullablePaginationEntity{Page: ptr.Of(page), Last: ptr.Of(pages)}
+		if page < pages {
+			pagination.Next = ptr.Of(page + 1)
+		}
+		return &testResponse{Items: items, Pagination: pagination}, nil
+	}
+}
+
+func TestPaginate_Concurrent(t *testing.T) {
+	// Assemble
+	var fetched, inFlight, maxInFlight int32
+	setPageFunc := func(request *testRequest, page *int) {
+		request.Page = page
+	}
+
+	// Act
+	result, err := Paginate(context.Background(), PaginateRequestOptions[testRequest, int]{
+		Request:            &testRequest{},
+		SetRequestPageFunc: setPageFunc,
+		GetPageFunc:        pagedRequestFunc(20, 5, &fetched, &inFlight, &maxInFlight),
+		Concurrency:        3,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 100 {
+		t.Fatalf("expected 100 items, got %d", len(result))
+	}
+	for i, item := range result {
+		if item != i+1 {
+			t.Fatalf("expected items in page order, got %d at index %d", item, i)
+		}
+	}
+	if fetched != 20 {
+		t.Errorf("expected 20 pages to be fetched, got %d", fetched)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 pages in flight, got %d", maxInFlight)
+	}
+}
+
+func TestPaginate_MaxItems(t *testing.T) {
+	// Assemble
+	var fetched, inFlight, maxInFlight int32
+	setPageFunc := func(request *testRequest, page *int) {
+		request.Page = page
+	}
+
+	// Act
+	result, err := Paginate(context.Background(), PaginateRequestOptions[testRequest, int]{
+		Request:            &testRequest{},
+		SetRequestPageFunc: setPageFunc,
+		GetPageFunc:        pagedRequestFunc(20, 5, &fetched, &inFlight, &maxInFlight),
+		MaxItems:           12,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(result, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}) {
+		t.Errorf("expected the first 12 items, got %v", result)
+	}
+	if fetched != 3 {
+		t.Errorf("expected 3 pages to be fetched, got %d", fetched)
+	}
+}
+
+func TestPaginate_ConcurrentError(t *testing.T) {
+	// Assemble
+	var fetched int32
+	setPageFunc := func(request *testRequest, page *int) {
+		request.Page = page
+	}
+	requestFunc := func(ctx context.Context, client *firehydrant.APIClient, request *testRequest) (PaginateResponse[int], diag.Diagnostics) {
+		atomic.AddInt32(&fetched, 1)
+		switch *request.Page {
+		case 1:
+			return &testResponse{Items: []int{1}, Pagination: &components.NullablePaginationEntity{Next: ptr.Of(2), Last: ptr.Of(50)}}, nil
+		case 2:
+			return nil, diag.Errorf("test error")
+		}
+		// The remaining pages only finish once the error cancelled them
+		<-ctx.Done()
+		return nil, diag.FromErr(ctx.Err())
+	}
+
+	// Act
+	result, err := Paginate(context.Background(), PaginateRequestOptions[testRequest, int]{
+		Request:            &testRequest{},
+		SetRequestPageFunc: setPageFunc,
+		GetPageFunc:        requestFunc,
+	})
+
+	// Assert
+	if err == nil || err[0].Summary != "test error" {
+		t.Fatalf("expected the page's error, got %v", err)
+	}
+	if len(result) != 0 {
+		t.Errorf("expected 0 items, got %d", len(result))
+	}
+	if fetched > 1+DefaultConcurrency {
+		t.Errorf("expected no pages to be fetched after the error, got %d", fetched)
+	}
+}
+
+func TestPaginate_Cancelled(t *testing.T) {
+	// Assemble
+	ctx, cancel := context.WithCancel(context.Background())
+	setPageFunc := func(request *testRequest, page *int) {
+		request.Page = page
+	}
+	requestFunc := func(ctx context.Context, client *firehydrant.APIClient, request *testRequest) (PaginateResponse[int], diag.Diagnostics) {
+		cancel()
+		return &testResponse{Items: []int{1}, Pagination: &components.NullablePaginationEntity{Next: ptr.Of(*request.Page + 1), Last: ptr.Of(50)}}, nil
+	}
+
+	// Act
+	_, err := Paginate(ctx, PaginateRequestOptions[testRequest, int]{
+		Request:            &testRequest{},
+		SetRequestPageFunc: setPageFunc,
+		GetPageFunc:        requestFunc,
+	})
+
+	// Assert
+	if err == nil {
+		t.Fatalf("expected an error once the context was cancelled")
+	}
+}
+
+func TestPaginate_RESTPage(t *testing.T) {
+	// Assemble
+	setPageFunc := func(request *testRequest, page *int) {
+		request.Page = page
+	}
+	requestFunc := func(ctx context.Context, client *firehydrant.APIClient, request *testRequest) (PaginateResponse[int], diag.Diagnostics) {
+		page := *request.Page
+		pagination := &firehydrant.Pagination{Page: page, Pages: 3, Last: 3}
+		if page < 3 {
+			pagination.Next = page + 1
+		}
+		return RESTPage[int]{Data: []int{page}, Pagination: pagination}, nil
+	}
+
+	// Act
+	result, err := Paginate(context.Background(), PaginateRequestOptions[testRequest, int]{
+		Request:            &testRequest{},
+		SetRequestPageFunc: setPageFunc,
+		GetPageFunc:        requestFunc,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(result, []int{1, 2, 3}) {
+		t.Errorf("expected every page, got %v", result)
+	}
+}
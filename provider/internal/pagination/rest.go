@@ -0,0 +1,37 @@
+package pagination
+
+import (
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+)
+
+// RESTPage adapts a page returned by a list call of the sling-based REST client to
+// PaginateResponse, so those calls can be paginated with Paginate like SDK calls
+//
+//	GetPageFunc: func(ctx context.Context, _ *firehydrant.APIClient, params *firehydrant.GetUserParams) (pagination.PaginateResponse[firehydrant.User], diag.Diagnostics) {
+//		response, err := client.GetUsers(ctx, *params)
+//		if err != nil {
+//			return nil, diag.FromErr(err)
+//		}
+//		return pagination.RESTPage[firehydrant.User]{Data: response.Users, Pagination: response.Pagination}, nil
+//	},
+type RESTPage[TEntity any] struct {
+	Data       []TEntity
+	Pagination *firehydrant.Pagination
+}
+
+func (p RESTPage[TEntity]) GetData() []TEntity {
+	return p.Data
+}
+
+func (p RESTPage[TEntity]) GetPagination() *components.NullablePaginationEntity {
+	if p.Pagination == nil || p.Pagination.Next == 0 {
+		return nil
+	}
+	pagination := &components.NullablePaginationEntity{Next: ptr.Of(p.Pagination.Next)}
+	if p.Pagination.Last > 0 {
+		pagination.Last = ptr.Of(p.Pagination.Last)
+	}
+	return pagination
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultLabelsName = "default_labels"
+	labelsAllName     = "labels_all"
+)
+
+// labelsAllSchema is the computed labels_all attribute of every resource with labels. It
+// holds the labels the resource has in FireHydrant, including the provider's default_labels.
+func labelsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All labels of the resource, including those inherited from the provider's default_labels.",
+	}
+}
+
+// mergedLabels returns the labels to send to the API: the provider's default labels
+// overridden by the labels set on the resource
+func mergedLabels(client *firehydrant.APIClient, labels map[string]interface{}) map[string]string {
+	merged := maps.Clone(client.DefaultLabels())
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, convertStringMap(labels))
+	return merged
+}
+
+// setLabels sets labels_all to the labels the API returned, and labels to the ones the
+// resource sets itself. A label inherited from default_labels is left out of labels, so it
+// doesn't show up as a diff, unless the configuration sets it as well.
+func setLabels(client *firehydrant.APIClient, d *schema.ResourceData, apiLabels map[string]string) error {
+	if err := d.Set(labelsAllName, apiLabels); err != nil {
+		return err
+	}
+
+	defaults := client.DefaultLabels()
+	configured := d.Get("labels").(map[string]interface{})
+	labels := make(map[string]string, len(apiLabels))
+	for key, value := range apiLabels {
+		if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+			if _, ok := configured[key]; !ok {
+				continue
+			}
+		}
+		labels[key] = value
+	}
+	return d.Set("labels", labels)
+}
+
+// customizeDiffLabels plans labels_all from labels and the provider's default_labels, so
+// adding, changing or removing a default label updates every resource that inherits it
+func customizeDiffLabels(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*firehydrant.APIClient)
+	if !ok {
+		return nil
+	}
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed(labelsAllName)
+	}
+
+	merged := mergedLabels(client, d.Get("labels").(map[string]interface{}))
+	current := convertStringMap(d.Get(labelsAllName).(map[string]interface{}))
+	if maps.Equal(current, merged) {
+		return nil
+	}
+	return d.SetNew(labelsAllName, merged)
+}
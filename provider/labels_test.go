@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestOfflineDefaultLabels(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDefaultLabelsConfig(server, `{ team = "platform", env = "prod" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels.%", "1"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels.env", "staging"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels_all.%", "2"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels_all.env", "staging"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels_all.team", "platform"),
+					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "labels.%", "0"),
+					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "labels_all.%", "2"),
+					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "labels_all.env", "prod"),
+					testCheckFakeAPILabels(server, "firehydrant_service.test_service", "/v1/services/", map[string]string{"team": "platform", "env": "staging"}),
+					testCheckFakeAPILabels(server, "firehydrant_functionality.test_functionality", "/v1/functionalities/", map[string]string{"team": "platform", "env": "prod"}),
+				),
+			},
+			{
+				Config: testDefaultLabelsConfig(server, `{ team = "sre" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels.%", "1"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels_all.team", "sre"),
+					resource.TestCheckResourceAttr("firehydrant_functionality.test_functionality", "labels_all.%", "1"),
+					testCheckFakeAPILabels(server, "firehydrant_service.test_service", "/v1/services/", map[string]string{"team": "sre", "env": "staging"}),
+					testCheckFakeAPILabels(server, "firehydrant_functionality.test_functionality", "/v1/functionalities/", map[string]string{"team": "sre"}),
+				),
+			},
+			{
+				Config: testDefaultLabelsConfig(server, `{}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels.%", "1"),
+					resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels_all.%", "1"),
+					testCheckFakeAPILabels(server, "firehydrant_service.test_service", "/v1/services/", map[string]string{"env": "staging"}),
+				),
+			},
+		},
+	})
+}
+
+// testCheckFakeAPILabels checks the labels the fake API stored for the resource
+func testCheckFakeAPILabels(server *fakeapi.Server, resourceName, collectionPath string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		obj, ok := server.Get(collectionPath + rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("%s %s does not exist in the fake API", resourceName, rs.Primary.ID)
+		}
+
+		labels, _ := obj["labels"].(map[string]interface{})
+		if len(labels) != len(expected) {
+			return fmt.Errorf("Expected labels %v, got %v", expected, labels)
+		}
+		for key, value := range expected {
+			if labels[key] != value {
+				return fmt.Errorf("Expected labels %v, got %v", expected, labels)
+			}
+		}
+		return nil
+	}
+}
+
+func testDefaultLabelsConfig(server *fakeapi.Server, defaultLabels string) string {
+	return fmt.Sprintf(`
+provider "firehydrant" {
+  api_key              = "test-token-very-authorized"
+  firehydrant_base_url = %q
+  default_labels       = %s
+}
+
+resource "firehydrant_service" "test_service" {
+  name = "tf-acc-service-default-labels"
+  labels = {
+    env = "staging"
+  }
+}
+
+resource "firehydrant_functionality" "test_functionality" {
+  name = "tf-acc-functionality-default-labels"
+}
+`, server.BaseURL(), defaultLabels)
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,8 +18,9 @@ func resourceLifecycleMilestone() *schema.Resource {
 		ReadContext:   readResourceLifecycleMilestone,
 		UpdateContext: updateResourceLifecycleMilestone,
 		DeleteContext: deleteResourceLifecycleMilestone,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: lifecycleMilestoneImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -199,7 +199,7 @@ func deleteResourceLifecycleMilestone(ctx context.Context, d *schema.ResourceDat
 	})
 	err := client.Sdk.IncidentSettings.DeleteLifecycleMilestone(ctx, id)
 	if err != nil {
-		if err.(*sdkerrors.SDKError).StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -208,3 +208,26 @@ func deleteResourceLifecycleMilestone(ctx context.Context, d *schema.ResourceDat
 
 	return diag.Diagnostics{}
 }
+
+var lifecycleMilestoneImportLookup = importLookup{
+	resourceName: "lifecycle milestone",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		// Milestones are only listed as part of their phases
+		phases, err := client.Sdk.IncidentSettings.ListLifecyclePhases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []importCandidate
+		for _, phase := range phases.GetData() {
+			for _, milestone := range phase.GetMilestones() {
+				candidates = append(candidates, importCandidate{
+					ID:   stringValue(milestone.GetID()),
+					Name: stringValue(milestone.GetName()),
+					Slug: stringValue(milestone.GetSlug()),
+				})
+			}
+		}
+		return candidates, nil
+	},
+}
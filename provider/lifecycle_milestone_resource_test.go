@@ -20,9 +20,9 @@ func TestAccLifecycleMilestoneResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: defaultProviderFactories(),
-		CheckDestroy:      testAccCheckLifecycleMilestoneResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:             testAccCheckLifecycleMilestoneResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLifecycleMilestoneResourceConfig_basic(rName),
@@ -31,7 +31,7 @@ func TestAccLifecycleMilestoneResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "id"),
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "phase_id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("Test Milestone %s", rName)),
+						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("tf-acc-milestone-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_lifecycle_milestone.new_milestone", "description", fmt.Sprintf("test description %s", rName)),
 				),
@@ -46,9 +46,9 @@ func TestAccLifecycleMilestoneResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: defaultProviderFactories(),
-		CheckDestroy:      testAccCheckLifecycleMilestoneResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		CheckDestroy:             testAccCheckLifecycleMilestoneResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLifecycleMilestoneResourceConfig_basic(rName),
@@ -57,7 +57,7 @@ func TestAccLifecycleMilestoneResource_update(t *testing.T) {
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "id"),
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "phase_id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("Test Milestone %s", rName)),
+						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("tf-acc-milestone-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_lifecycle_milestone.new_milestone", "description", fmt.Sprintf("test description %s", rName)),
 				),
@@ -69,11 +69,11 @@ func TestAccLifecycleMilestoneResource_update(t *testing.T) {
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "id"),
 					resource.TestCheckResourceAttrSet("firehydrant_lifecycle_milestone.new_milestone", "phase_id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("Test Milestone %s", rNameUpdated)),
+						"firehydrant_lifecycle_milestone.new_milestone", "name", fmt.Sprintf("tf-acc-milestone-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_lifecycle_milestone.new_milestone", "description", fmt.Sprintf("test description %s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
-						"firehydrant_lifecycle_milestone.new_milestone", "slug", fmt.Sprintf("test-milestone-%s", rNameUpdated)),
+						"firehydrant_lifecycle_milestone.new_milestone", "slug", fmt.Sprintf("tf-acc-milestone-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_lifecycle_milestone.new_milestone", "position", "2"),
 					resource.TestCheckResourceAttr(
@@ -89,8 +89,8 @@ func TestAccLifecycleMilestoneResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: defaultProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: defaultProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLifecycleMilestoneResourceConfig_basic(rName),
@@ -195,7 +195,7 @@ data "firehydrant_lifecycle_phase" "started" {
 }
 
 resource "firehydrant_lifecycle_milestone" "new_milestone" {
-  name        = "Test Milestone %s"
+  name        = "tf-acc-milestone-%s"
   description = "test description %s"
 	phase_id    = data.firehydrant_lifecycle_phase.started.id
 }`, rName, rName)
@@ -208,10 +208,10 @@ data "firehydrant_lifecycle_phase" "started" {
 }
 
 resource "firehydrant_lifecycle_milestone" "new_milestone" {
-  name        = "Test Milestone %s"
+  name        = "tf-acc-milestone-%s"
   description = "test description %s"
 	phase_id    = data.firehydrant_lifecycle_phase.started.id
-	slug        = "test-milestone-%s"
+	slug        = "tf-acc-milestone-%s"
 	position    = 2
 	auto_assign_timestamp_on_create = "never_set_on_create"
 }`, rName, rName, rName)
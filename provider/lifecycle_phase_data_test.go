@@ -10,8 +10,8 @@ import (
 func TestAccLifecyclePhaseDataSource_basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: defaultProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: defaultProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLifecyclePhaseDataSourceConfig_basic(),
@@ -27,8 +27,8 @@ func TestAccLifecyclePhaseDataSource_basic(t *testing.T) {
 func TestAccLifecyclePhaseDataSource_invalid(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: defaultProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: defaultProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccLifecyclePhaseDataSourceConfig_invalid(),
@@ -150,3 +150,35 @@ func dataFireHydrantOnCallScheduleToAttributesMap(teamID string, schedule compon
 
 	return attributes
 }
+
+func strategyToMapSDK(strategy components.NullableSignalsAPIOnCallStrategyEntity) []map[string]interface{} {
+	m := map[string]interface{}{"type": *strategy.GetType()}
+	if *strategy.GetType() == "custom" {
+		if shiftDuration := strategy.GetShiftDuration(); shiftDuration != nil {
+			m["shift_duration"] = *shiftDuration
+		}
+	} else {
+		if handoffTime := strategy.GetHandoffTime(); handoffTime != nil {
+			m["handoff_time"] = *handoffTime
+		}
+	}
+	if *strategy.GetType() == "weekly" {
+		if handoffDay := strategy.GetHandoffDay(); handoffDay != nil {
+			m["handoff_day"] = *handoffDay
+		}
+	}
+	return []map[string]interface{}{m}
+}
+
+func restrictionsToDataSDK(restrictions []components.SignalsAPIOnCallRestrictionEntity) []map[string]interface{} {
+	restrictionMaps := make([]map[string]interface{}, 0)
+	for _, restriction := range restrictions {
+		restrictionMaps = append(restrictionMaps, map[string]interface{}{
+			"start_day":  *restriction.GetStartDay(),
+			"start_time": *restriction.GetStartTime(),
+			"end_day":    *restriction.GetEndDay(),
+			"end_time":   *restriction.GetEndTime(),
+		})
+	}
+	return restrictionMaps
+}
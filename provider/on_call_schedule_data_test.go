@@ -22,11 +22,11 @@ func TestOnCallScheduleData(t *testing.T) {
 func (s *testOnCallScheduleDataSuite) terraform(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "team_team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule_data_1" {
-  name        = "test-on-call-schedule-%s"
+  name        = "tf-acc-on-call-schedule-%s"
   description = "test-description"
 	team_id     = firehydrant_team.team_team.id
   time_zone   = "America/Los_Angeles"
@@ -57,11 +57,11 @@ func (s *testOnCallScheduleDataSuite) terraformWithCustomStrategy(rName string)
 	futureTime := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
 	return fmt.Sprintf(`
 resource "firehydrant_team" "team_team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule_data_1" {
-  name        = "test-on-call-schedule-%s"
+  name        = "tf-acc-on-call-schedule-%s"
   description = "test-description"
 	team_id     = firehydrant_team.team_team.id
   time_zone   = "America/Los_Angeles"
@@ -82,11 +82,11 @@ data "firehydrant_on_call_schedule" "test_on_call_schedule_data" {
 func (s *testOnCallScheduleDataSuite) terraformWithoutRestrictions(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "team_team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule_data_1" {
-  name        = "test-on-call-schedule-%s"
+  name        = "tf-acc-on-call-schedule-%s"
   description = "test-description"
 	team_id     = firehydrant_team.team_team.id
   time_zone   = "America/Los_Angeles"
@@ -107,8 +107,8 @@ data "firehydrant_on_call_schedule" "test_on_call_schedule_data" {
 
 func (s *testOnCallScheduleDataSuite) testResource(steps ...resource.TestStep) {
 	resource.Test(s.T(), resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(s.T()) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(s.T()) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 			testAccCheckTeamResourceDestroy(),
@@ -124,8 +124,8 @@ func TestAccOnCallScheduleDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 			testAccCheckTeamResourceDestroy(),
@@ -151,11 +151,11 @@ func TestAccOnCallScheduleDataSource_basic(t *testing.T) {
 func testAccOnCallScheduleDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "team_team" {
-	name = "test-team-acc-data-source-%s"
+	name = "tf-acc-team-acc-data-source-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_schedule" {
-	name        = "test-on-call-schedule-acc-data-source-%s"
+	name        = "tf-acc-on-call-schedule-acc-data-source-%s"
 	description = "test-description"
 	team_id     = firehydrant_team.team_team.id
 	time_zone   = "America/New_York"
@@ -189,7 +189,7 @@ func (s *testOnCallScheduleDataSuite) TestSuccess() {
 			Check: resource.ComposeAggregateTestCheckFunc(
 				resource.TestCheckResourceAttrSet("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "id"),
 				resource.TestCheckResourceAttrSet("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "team_id"),
-				resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "name", fmt.Sprintf("test-on-call-schedule-%s", rName)),
+				resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "name", fmt.Sprintf("tf-acc-on-call-schedule-%s", rName)),
 				resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "description", "test-description"),
 				resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "time_zone", "America/Los_Angeles"),
 				resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule.test_on_call_schedule_data", "slack_user_group_id", "test-slack-user-group-id"),
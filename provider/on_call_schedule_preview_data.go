@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/senseyeio/duration"
+)
+
+// maxPreviewShifts limits the shifts a preview returns, so a short shift duration and a long
+// window can't produce an unreasonably large plan
+const maxPreviewShifts = 5000
+
+func dataSourceOnCallSchedulePreview() *schema.Resource {
+	previewSchema := rotationPreviewSchema()
+	previewSchema["window_start"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "RFC3339 timestamp of the start of the previewed window",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+	}
+	previewSchema["window_end"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "RFC3339 timestamp of the end of the previewed window",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+	}
+	previewSchema["shifts"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataFireHydrantOnCallSchedulePreview,
+		Schema:      previewSchema,
+	}
+}
+
+// rotationPreviewSchema declares the arguments of a rotation that its shifts are computed
+// from, matching those of firehydrant_rotation
+func rotationPreviewSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Required
+		"time_zone": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateWith(checkTimeZone),
+		},
+		"strategy": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(rotationStrategyTypes, true),
+					},
+					"handoff_time": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
+					},
+					"handoff_day": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkWeekday),
+					},
+					"shift_duration": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkShiftDuration),
+					},
+				},
+			},
+		},
+
+		// Optional
+		"start_time": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "RFC3339 timestamp of when the first member's shift starts. Defaults to window_start.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"members": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The ID of the user. Leave empty for an unassigned slot in the rotation.",
+					},
+				},
+			},
+		},
+		"restrictions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(weekdays, true),
+					},
+					"start_time": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
+					},
+					"end_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(weekdays, true),
+					},
+					"end_time": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantOnCallSchedulePreview(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	windowStart, _ := time.Parse(time.RFC3339, d.Get("window_start").(string))
+	windowEnd, _ := time.Parse(time.RFC3339, d.Get("window_end").(string))
+	if !windowEnd.After(windowStart) {
+		return diag.Errorf("window_end must be after window_start")
+	}
+
+	preview, err := rotationPreviewFromMap(map[string]interface{}{
+		"time_zone":    d.Get("time_zone"),
+		"start_time":   d.Get("start_time"),
+		"strategy":     d.Get("strategy"),
+		"members":      d.Get("members"),
+		"restrictions": d.Get("restrictions"),
+	}, windowStart)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shifts, err := preview.shifts(windowStart, windowEnd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shiftMaps := make([]map[string]interface{}, 0, len(shifts))
+	for _, shift := range shifts {
+		shiftMaps = append(shiftMaps, map[string]interface{}{
+			"start_time": shift.start.Format(time.RFC3339),
+			"end_time":   shift.end.Format(time.RFC3339),
+			"user_id":    shift.userID,
+		})
+	}
+	if err := d.Set("shifts", shiftMaps); err != nil {
+		return diag.Errorf("Error setting shifts: %v", err)
+	}
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diag.Diagnostics{}
+}
+
+// rotationPreviewFromMap builds a preview from the attributes declared by
+// rotationPreviewSchema. The first shift starts at defaultStart unless start_time is set.
+func rotationPreviewFromMap(attributes map[string]interface{}, defaultStart time.Time) (rotationPreview, error) {
+	location, err := time.LoadLocation(attributes["time_zone"].(string))
+	if err != nil {
+		return rotationPreview{}, fmt.Errorf("invalid time_zone: %w", err)
+	}
+
+	preview := rotationPreview{
+		location:  location,
+		startTime: defaultStart,
+	}
+	if v, _ := attributes["start_time"].(string); v != "" {
+		preview.startTime, _ = time.Parse(time.RFC3339, v)
+	}
+	if strategies, _ := attributes["strategy"].([]interface{}); len(strategies) > 0 {
+		strategy, _ := strategies[0].(map[string]interface{})
+		strategyType, _ := strategy["type"].(string)
+		preview.strategyType = strings.ToLower(strategyType)
+		preview.handoffTime, _ = strategy["handoff_time"].(string)
+		preview.handoffDay, _ = strategy["handoff_day"].(string)
+		preview.shiftDuration, _ = strategy["shift_duration"].(string)
+	}
+	members, _ := attributes["members"].([]interface{})
+	for _, member := range members {
+		userID := ""
+		if memberMap, ok := member.(map[string]interface{}); ok {
+			userID, _ = memberMap["user_id"].(string)
+		}
+		preview.members = append(preview.members, userID)
+	}
+	restrictions, _ := attributes["restrictions"].([]interface{})
+	for _, r := range restrictions {
+		restriction := r.(map[string]interface{})
+		preview.restrictions = append(preview.restrictions, rotationRestriction{
+			startDay:  restriction["start_day"].(string),
+			startTime: restriction["start_time"].(string),
+			endDay:    restriction["end_day"].(string),
+			endTime:   restriction["end_time"].(string),
+		})
+	}
+	return preview, nil
+}
+
+// rotationPreview computes the shifts of a rotation locally. Handoffs are computed in the
+// rotation's time zone, so a daily handoff stays at the same local time across daylight
+// saving time changes and the shift spanning the change is an hour shorter or longer.
+type rotationPreview struct {
+	location      *time.Location
+	startTime     time.Time
+	strategyType  string
+	handoffTime   string
+	handoffDay    string
+	shiftDuration string
+	// members are user IDs in rotation order, an empty ID is an unassigned slot
+	members      []string
+	restrictions []rotationRestriction
+}
+
+// rotationRestriction is a weekly window the rotation's shifts are restricted to
+type rotationRestriction struct {
+	startDay  string
+	startTime string
+	endDay    string
+	endTime   string
+}
+
+type previewShift struct {
+	start  time.Time
+	end    time.Time
+	userID string
+}
+
+// shifts returns the shifts between from and to, cut to the window and the restrictions
+func (p rotationPreview) shifts(from, to time.Time) ([]previewShift, error) {
+	var shifts []previewShift
+	start := p.startTime.In(p.location)
+	for i := 0; start.Before(to); i++ {
+		end, err := p.nextHandoff(start)
+		if err != nil {
+			return nil, err
+		}
+
+		if end.After(from) {
+			userID := ""
+			if len(p.members) > 0 {
+				userID = p.members[i%len(p.members)]
+			}
+			covered, err := p.covered(laterOf(start, from.In(p.location)), earlierOf(end, to.In(p.location)))
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range covered {
+				shifts = append(shifts, previewShift{start: c.start, end: c.end, userID: userID})
+			}
+			if len(shifts) > maxPreviewShifts {
+				return nil, fmt.Errorf("the window has more than %d shifts, choose a shorter window", maxPreviewShifts)
+			}
+		}
+		start = end
+	}
+	return shifts, nil
+}
+
+// nextHandoff returns the handoff following t
+func (p rotationPreview) nextHandoff(t time.Time) (time.Time, error) {
+	switch p.strategyType {
+	case "daily":
+		hour, minute, second, err := parseClock(p.handoffTime)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("strategy.handoff_time is required when strategy type is 'daily': %w", err)
+		}
+		handoff := time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, p.location)
+		if !handoff.After(t) {
+			handoff = time.Date(t.Year(), t.Month(), t.Day()+1, hour, minute, second, 0, p.location)
+		}
+		return handoff, nil
+	case "weekly":
+		hour, minute, second, err := parseClock(p.handoffTime)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("strategy.handoff_time is required when strategy type is 'weekly': %w", err)
+		}
+		weekday, err := parseWeekday(p.handoffDay)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("strategy.handoff_day is required when strategy type is 'weekly': %w", err)
+		}
+		days := (int(weekday) - int(t.Weekday()) + 7) % 7
+		handoff := time.Date(t.Year(), t.Month(), t.Day()+days, hour, minute, second, 0, p.location)
+		if !handoff.After(t) {
+			handoff = time.Date(t.Year(), t.Month(), t.Day()+days+7, hour, minute, second, 0, p.location)
+		}
+		return handoff, nil
+	case "custom":
+		d, err := duration.ParseISO8601(p.shiftDuration)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("strategy.shift_duration is required when strategy type is 'custom': %w", err)
+		}
+		handoff := d.Shift(t)
+		if !handoff.After(t) {
+			return time.Time{}, fmt.Errorf("strategy.shift_duration must be longer than zero, got %s", p.shiftDuration)
+		}
+		return handoff, nil
+	}
+	return time.Time{}, fmt.Errorf("unknown strategy type %q", p.strategyType)
+}
+
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// covered returns the parts of [from, to) the restrictions cover, all of it when there are no
+// restrictions
+func (p rotationPreview) covered(from, to time.Time) ([]timeRange, error) {
+	if len(p.restrictions) == 0 {
+		return []timeRange{{start: from, end: to}}, nil
+	}
+
+	// Restrictions can wrap into the next week, so start from the week before
+	weekStart := time.Date(from.Year(), from.Month(), from.Day()-int(from.Weekday())-7, 0, 0, 0, 0, p.location)
+	var ranges []timeRange
+	for ; weekStart.Before(to); weekStart = weekStart.AddDate(0, 0, 7) {
+		for _, restriction := range p.restrictions {
+			r, err := restriction.in(weekStart)
+			if err != nil {
+				return nil, err
+			}
+			start, end := laterOf(r.start, from), earlierOf(r.end, to)
+			if start.Before(end) {
+				ranges = append(ranges, timeRange{start: start, end: end})
+			}
+		}
+	}
+
+	return mergeRanges(ranges), nil
+}
+
+// mergeRanges sorts ranges and merges those that overlap or touch
+func mergeRanges(ranges []timeRange) []timeRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start.Before(ranges[j].start) })
+	var merged []timeRange
+	for _, r := range ranges {
+		if last := len(merged) - 1; last >= 0 && !r.start.After(merged[last].end) {
+			merged[last].end = laterOf(merged[last].end, r.end)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// in returns the restriction in the week starting at weekStart, a Sunday midnight
+func (r rotationRestriction) in(weekStart time.Time) (timeRange, error) {
+	startDay, err := parseWeekday(r.startDay)
+	if err != nil {
+		return timeRange{}, fmt.Errorf("invalid restriction start_day: %w", err)
+	}
+	endDay, err := parseWeekday(r.endDay)
+	if err != nil {
+		return timeRange{}, fmt.Errorf("invalid restriction end_day: %w", err)
+	}
+	startHour, startMinute, startSecond, err := parseClock(r.startTime)
+	if err != nil {
+		return timeRange{}, fmt.Errorf("invalid restriction start_time: %w", err)
+	}
+	endHour, endMinute, endSecond, err := parseClock(r.endTime)
+	if err != nil {
+		return timeRange{}, fmt.Errorf("invalid restriction end_time: %w", err)
+	}
+
+	year, month, day := weekStart.Date()
+	location := weekStart.Location()
+	start := time.Date(year, month, day+int(startDay), startHour, startMinute, startSecond, 0, location)
+	end := time.Date(year, month, day+int(endDay), endHour, endMinute, endSecond, 0, location)
+	if !end.After(start) {
+		end = time.Date(year, month, day+int(endDay)+7, endHour, endMinute, endSecond, 0, location)
+	}
+	return timeRange{start: start, end: end}, nil
+}
+
+// parseClock parses a time of day written as HH:MM:SS or HH:MM
+func parseClock(s string) (int, int, int, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Hour(), t.Minute(), t.Second(), nil
+		}
+	}
+	return 0, 0, 0, fmt.Errorf("expected a time of day in HH:MM:SS format, got %q", s)
+}
+
+// parseWeekday parses the lowercase day names used by strategies and restrictions
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("expected a day of the week, e.g. monday, got %q", s)
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRotationPreviewShifts(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	cases := []struct {
+		name     string
+		preview  rotationPreview
+		from, to string
+		shifts   []string
+	}{
+		{
+			name: "daily across the start of daylight saving time",
+			preview: rotationPreview{
+				location:     newYork,
+				startTime:    parse("2024-03-08T09:00:00-05:00"),
+				strategyType: "daily",
+				handoffTime:  "09:00:00",
+				members:      []string{"alice", "bob"},
+			},
+			from: "2024-03-09T00:00:00-05:00",
+			to:   "2024-03-11T09:00:00-04:00",
+			shifts: []string{
+				"2024-03-09T00:00:00-05:00 2024-03-09T09:00:00-05:00 alice",
+				"2024-03-09T09:00:00-05:00 2024-03-10T09:00:00-04:00 bob",
+				"2024-03-10T09:00:00-04:00 2024-03-11T09:00:00-04:00 alice",
+			},
+		},
+		{
+			name: "weekly with an unassigned slot",
+			preview: rotationPreview{
+				location:     newYork,
+				startTime:    parse("2024-10-28T12:00:00-04:00"),
+				strategyType: "weekly",
+				handoffTime:  "10:00:00",
+				handoffDay:   "thursday",
+				members:      []string{"alice", ""},
+			},
+			from: "2024-10-28T12:00:00-04:00",
+			to:   "2024-11-14T10:00:00-05:00",
+			shifts: []string{
+				"2024-10-28T12:00:00-04:00 2024-10-31T10:00:00-04:00 alice",
+				"2024-10-31T10:00:00-04:00 2024-11-07T10:00:00-05:00 ",
+				"2024-11-07T10:00:00-05:00 2024-11-14T10:00:00-05:00 alice",
+			},
+		},
+		{
+			name: "custom restricted to business hours",
+			preview: rotationPreview{
+				location:      newYork,
+				startTime:     parse("2024-06-07T00:00:00-04:00"),
+				strategyType:  "custom",
+				shiftDuration: "P1D",
+				members:       []string{"alice", "bob", "carol"},
+				restrictions: []rotationRestriction{
+					{startDay: "monday", startTime: "09:00:00", endDay: "friday", endTime: "17:00:00"},
+				},
+			},
+			from: "2024-06-07T00:00:00-04:00",
+			to:   "2024-06-11T00:00:00-04:00",
+			shifts: []string{
+				"2024-06-07T00:00:00-04:00 2024-06-07T17:00:00-04:00 alice",
+				"2024-06-10T09:00:00-04:00 2024-06-11T00:00:00-04:00 alice",
+			},
+		},
+		{
+			name: "restriction wrapping into the next week",
+			preview: rotationPreview{
+				location:      newYork,
+				startTime:     parse("2024-06-01T00:00:00-04:00"),
+				strategyType:  "custom",
+				shiftDuration: "P7D",
+				members:       []string{"alice"},
+				restrictions: []rotationRestriction{
+					{startDay: "saturday", startTime: "20:00:00", endDay: "sunday", endTime: "08:00:00"},
+				},
+			},
+			from: "2024-06-02T00:00:00-04:00",
+			to:   "2024-06-09T00:00:00-04:00",
+			shifts: []string{
+				"2024-06-02T00:00:00-04:00 2024-06-02T08:00:00-04:00 alice",
+				"2024-06-08T20:00:00-04:00 2024-06-09T00:00:00-04:00 alice",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			shifts, err := c.preview.shifts(parse(c.from), parse(c.to))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, 0, len(shifts))
+			for _, shift := range shifts {
+				got = append(got, fmt.Sprintf("%s %s %s", shift.start.Format(time.RFC3339), shift.end.Format(time.RFC3339), shift.userID))
+			}
+			if strings.Join(got, "\n") != strings.Join(c.shifts, "\n") {
+				t.Fatalf("expected shifts\n%s\ngot\n%s", strings.Join(c.shifts, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestOfflineOnCallSchedulePreview(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + `
+data "firehydrant_on_call_schedule_preview" "test" {
+  time_zone    = "Europe/London"
+  window_start = "2024-10-26T00:00:00Z"
+  window_end   = "2024-10-28T00:00:00Z"
+
+  strategy {
+    type         = "daily"
+    handoff_time = "09:00:00"
+  }
+
+  members {
+    user_id = "alice"
+  }
+  members {
+    user_id = "bob"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.#", "3"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.0.start_time", "2024-10-26T01:00:00+01:00"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.0.user_id", "alice"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.1.start_time", "2024-10-26T09:00:00+01:00"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.1.end_time", "2024-10-27T09:00:00Z"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.1.user_id", "bob"),
+					resource.TestCheckResourceAttr("data.firehydrant_on_call_schedule_preview.test", "shifts.2.end_time", "2024-10-28T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
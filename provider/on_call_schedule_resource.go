@@ -2,242 +2,465 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-	"github.com/hashicorp/go-cty/cty"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceOnCallSchedule() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: createResourceFireHydrantOnCallSchedule,
-		ReadContext:   readResourceFireHydrantOnCallSchedule,
-		UpdateContext: updateResourceFireHydrantOnCallSchedule,
-		DeleteContext: deleteResourceFireHydrantOnCallSchedule,
-		Importer: &schema.ResourceImporter{
-			StateContext: importResourceFireHydrantOnCallSchedule,
-		},
+// onCallScheduleResource is implemented with the plugin framework. Its schema keeps the
+// blocks and attribute types of the plugin SDK resource it replaced, so existing state
+// still applies.
+type onCallScheduleResource struct {
+	client *firehydrant.APIClient
+}
 
-		Schema: map[string]*schema.Schema{
-			"team_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+var (
+	_ resource.Resource                   = &onCallScheduleResource{}
+	_ resource.ResourceWithConfigure      = &onCallScheduleResource{}
+	_ resource.ResourceWithImportState    = &onCallScheduleResource{}
+	_ resource.ResourceWithUpgradeState   = &onCallScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &onCallScheduleResource{}
+)
+
+func newOnCallScheduleResource() resource.Resource {
+	return &onCallScheduleResource{}
+}
+
+type onCallScheduleResourceModel struct {
+	ID                  types.String                     `tfsdk:"id"`
+	TeamID              types.String                     `tfsdk:"team_id"`
+	Name                types.String                     `tfsdk:"name"`
+	Description         types.String                     `tfsdk:"description"`
+	RotationName        types.String                     `tfsdk:"rotation_name"`
+	RotationDescription types.String                     `tfsdk:"rotation_description"`
+	MemberIDs           types.List                       `tfsdk:"member_ids"`
+	Members             types.List                       `tfsdk:"members"`
+	TimeZone            types.String                     `tfsdk:"time_zone"`
+	Strategy            []onCallScheduleStrategyModel    `tfsdk:"strategy"`
+	StartTime           types.String                     `tfsdk:"start_time"`
+	Color               types.String                     `tfsdk:"color"`
+	SlackUserGroupID    types.String                     `tfsdk:"slack_user_group_id"`
+	Restrictions        []onCallScheduleRestrictionModel `tfsdk:"restrictions"`
+	EffectiveAt         types.String                     `tfsdk:"effective_at"`
+	Timeouts            timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+type onCallScheduleStrategyModel struct {
+	Type          types.String `tfsdk:"type"`
+	HandoffTime   types.String `tfsdk:"handoff_time"`
+	HandoffDay    types.String `tfsdk:"handoff_day"`
+	ShiftDuration types.String `tfsdk:"shift_duration"`
+}
+
+type onCallScheduleRestrictionModel struct {
+	StartDay  types.String `tfsdk:"start_day"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndDay    types.String `tfsdk:"end_day"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+func (r *onCallScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_on_call_schedule"
+}
+
+func (r *onCallScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = onCallScheduleSchema(ctx)
+}
+
+// onCallScheduleSchema is the current schema. State is upgraded from version 0, written by
+// the plugin SDK resource, see UpgradeState.
+func onCallScheduleSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"name": {
-				Type:     schema.TypeString,
+			"team_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
 				Required: true,
 			},
-			"description": {
-				Type:     schema.TypeString,
+			"description": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
 			},
-			"rotation_name": {
-				Type:     schema.TypeString,
+			"rotation_name": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 				Description: "Name of the schedule's primary rotation (the rotation FireHydrant " +
 					"creates alongside the schedule itself). Set this to override the default " +
 					"(which inherits the schedule's name). Tracked in state; mutations are sent " +
 					"to the schedule's PATCH endpoint.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"rotation_description": {
-				Type:     schema.TypeString,
+			"rotation_description": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 				Description: "Description of the schedule's primary rotation. Set this to override " +
 					"the default (which inherits the schedule's description). Tracked in state.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"member_ids": {
-				Type:          schema.TypeList,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				Optional:      true, // will be required in the future once `members` has been removed.
-				ConflictsWith: []string{"members"},
+			"member_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true, // will be required in the future once `members` has been removed.
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("members")),
+				},
 			},
-			"members": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
+			"members": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
 				// Technically, I (wilsonehusin) don't think this ever worked because it would produce HTTP 400s.
 				// Documentation also always mentioned `member_ids` as the correct attribute to use.
 				// Leaving this here for now to prevent potential breaking changes.
-				Deprecated:    "Use member_ids to configure membership; members attribute will be removed in a future release.",
-				ConflictsWith: []string{"member_ids"},
+				DeprecationMessage: "Use member_ids to configure membership; members attribute will be removed in a future release.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("member_ids")),
+				},
 			},
-			"time_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			"time_zone": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{timeZoneValidator},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"strategy": {
-				Type:     schema.TypeList, // Using TypeList to simulate a map
-				Required: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+			"start_time": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceIfChanged()},
+			},
+			"color": schema.StringAttribute{
+				Optional: true,
+			},
+			"slack_user_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"effective_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC3339 timestamp for when the schedule update should take effect. If not provided or if the time is in the past, the update will take effect immediately.",
+				Validators:  []validator.String{rfc3339Validator{}},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"strategy": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.IsRequired(), listvalidator.SizeBetween(1, 1)},
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplaceIf(
+					strategyRequiresReplace,
+					"Changing the strategy replaces the schedule.",
+					"Changing the strategy replaces the schedule.",
+				)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:      true,
+							Validators:    []validator.String{stringvalidator.OneOfCaseInsensitive(rotationStrategyTypes...)},
+							PlanModifiers: []planmodifier.String{caseInsensitive{}},
 						},
-						"handoff_time": {
-							Type:     schema.TypeString,
-							Optional: true,
+						"handoff_time": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{timeOfDayValidator},
 						},
-						"handoff_day": {
-							Type:     schema.TypeString,
-							Optional: true,
+						"handoff_day": schema.StringAttribute{
+							Optional:      true,
+							Validators:    []validator.String{weekdayValidator},
+							PlanModifiers: []planmodifier.String{caseInsensitive{}},
 						},
-						"shift_duration": {
-							Type:     schema.TypeString,
-							Optional: true,
+						"shift_duration": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{shiftDurationValidator},
 						},
 					},
 				},
 			},
-			"start_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"color": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"slack_user_group_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"restrictions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"start_day": {
-							Type:     schema.TypeString,
-							Required: true,
+			"restrictions": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"start_day": schema.StringAttribute{
+							Required:      true,
+							Validators:    []validator.String{stringvalidator.OneOfCaseInsensitive(weekdays...)},
+							PlanModifiers: []planmodifier.String{caseInsensitive{}},
 						},
-						"start_time": {
-							Type:     schema.TypeString,
-							Required: true,
+						"start_time": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{timeOfDayValidator},
 						},
-						"end_day": {
-							Type:     schema.TypeString,
-							Required: true,
+						"end_day": schema.StringAttribute{
+							Required:      true,
+							Validators:    []validator.String{stringvalidator.OneOfCaseInsensitive(weekdays...)},
+							PlanModifiers: []planmodifier.String{caseInsensitive{}},
 						},
-						"end_time": {
-							Type:     schema.TypeString,
-							Required: true,
+						"end_time": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{timeOfDayValidator},
 						},
 					},
 				},
 			},
-			"effective_at": {
-				Type:     schema.TypeString,
-				Optional: true,
-				// Don't set computed:true since we don't want it in the state
-				Description: "RFC3339 timestamp for when the schedule update should take effect. If not provided or if the time is in the past, the update will take effect immediately.",
-				ValidateDiagFunc: schema.SchemaValidateDiagFunc(
-					func(v interface{}, path cty.Path) diag.Diagnostics {
-						timeStr := v.(string)
-						_, err := time.Parse(time.RFC3339, timeStr)
-						if err != nil {
-							return diag.Diagnostics{
-								diag.Diagnostic{
-									Severity:      diag.Error,
-									Summary:       "Invalid effective_at timestamp",
-									Detail:        fmt.Sprintf("effective_at must be a valid RFC3339 timestamp (e.g. 2024-01-01T15:04:05Z), got: %s", timeStr),
-									AttributePath: path,
-								},
-							}
-						}
-
-						return nil
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// onCallScheduleSchemaV0 is the schema of the plugin SDK resource, which only needs the
+// attribute types to read state written with it
+func onCallScheduleSchemaV0(ctx context.Context) *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                   schema.StringAttribute{Computed: true},
+			"team_id":              schema.StringAttribute{Required: true},
+			"name":                 schema.StringAttribute{Required: true},
+			"description":          schema.StringAttribute{Optional: true},
+			"rotation_name":        schema.StringAttribute{Optional: true, Computed: true},
+			"rotation_description": schema.StringAttribute{Optional: true, Computed: true},
+			"member_ids":           schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"members":              schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"time_zone":            schema.StringAttribute{Required: true},
+			"start_time":           schema.StringAttribute{Optional: true},
+			"color":                schema.StringAttribute{Optional: true},
+			"slack_user_group_id":  schema.StringAttribute{Optional: true},
+			"effective_at":         schema.StringAttribute{Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			"strategy": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type":           schema.StringAttribute{Required: true},
+						"handoff_time":   schema.StringAttribute{Optional: true},
+						"handoff_day":    schema.StringAttribute{Optional: true},
+						"shift_duration": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+			"restrictions": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"start_day":  schema.StringAttribute{Required: true},
+						"start_time": schema.StringAttribute{Required: true},
+						"end_day":    schema.StringAttribute{Required: true},
+						"end_time":   schema.StringAttribute{Required: true},
 					},
-				),
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return true
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 		},
 	}
 }
 
-func createResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Get the API client
-	client := m.(*firehydrant.APIClient)
+func (r *onCallScheduleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   onCallScheduleSchemaV0(ctx),
+			StateUpgrader: upgradeOnCallScheduleStateV0,
+		},
+	}
+}
+
+// upgradeOnCallScheduleStateV0 leaves member_ids null when members are set with the
+// deprecated members attribute, as read does, and normalizes the empty strings the plugin
+// SDK stored for unused strategy settings and the restrictions to the form the API returns
+// them in
+func upgradeOnCallScheduleStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state onCallScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.Members.Elements()) > 0 {
+		state.MemberIDs = types.ListNull(types.StringType)
+	}
+	for i, strategy := range state.Strategy {
+		state.Strategy[i] = onCallScheduleStrategyModel{
+			Type:          strategy.Type,
+			HandoffTime:   stringValueOrNull(strategy.HandoffTime.ValueStringPointer()),
+			HandoffDay:    stringValueOrNull(strategy.HandoffDay.ValueStringPointer()),
+			ShiftDuration: stringValueOrNull(strategy.ShiftDuration.ValueStringPointer()),
+		}
+	}
+	for i, restriction := range state.Restrictions {
+		state.Restrictions[i] = onCallScheduleRestrictionModel{
+			StartDay:  types.StringValue(normalizeRestrictionDay(restriction.StartDay.ValueString())),
+			StartTime: types.StringValue(normalizeRestrictionTime(restriction.StartTime.ValueString())),
+			EndDay:    types.StringValue(normalizeRestrictionDay(restriction.EndDay.ValueString())),
+			EndTime:   types.StringValue(normalizeRestrictionTime(restriction.EndTime.ValueString())),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ValidateConfig checks the strategy and restriction settings that depend on each other, once
+// their values are known
+func (r *onCallScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var strategies, restrictions types.List
+	var startTime types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strategy"), &strategies)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("restrictions"), &restrictions)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_time"), &startTime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var strategy []onCallScheduleStrategyModel
+	if !strategies.IsUnknown() {
+		resp.Diagnostics.Append(strategies.ElementsAs(ctx, &strategy, false)...)
+	}
+	if len(strategy) == 1 && allKnown(strategy[0].Type, strategy[0].HandoffTime, strategy[0].HandoffDay, strategy[0].ShiftDuration, startTime) {
+		err := checkRotationStrategy(
+			strategy[0].Type.ValueString(),
+			strategy[0].HandoffTime.ValueString(),
+			strategy[0].HandoffDay.ValueString(),
+			strategy[0].ShiftDuration.ValueString(),
+			startTime.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("strategy"), "Invalid strategy", err.Error())
+		}
+	}
+
+	if restrictions.IsUnknown() {
+		return
+	}
+	var models []onCallScheduleRestrictionModel
+	resp.Diagnostics.Append(restrictions.ElementsAs(ctx, &models, false)...)
+	windows := make([]rotationRestriction, 0, len(models))
+	for _, m := range models {
+		if !allKnown(m.StartDay, m.StartTime, m.EndDay, m.EndTime) {
+			return
+		}
+		windows = append(windows, rotationRestriction{
+			startDay:  m.StartDay.ValueString(),
+			startTime: m.StartTime.ValueString(),
+			endDay:    m.EndDay.ValueString(),
+			endTime:   m.EndTime.ValueString(),
+		})
+	}
+	if err := checkRestrictionWindows(windows); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("restrictions"), "Invalid restrictions", err.Error())
+	}
+}
+
+// strategyRequiresReplace replaces the schedule when its strategy changes. An empty string
+// and null are the same, as state written by the plugin SDK has empty strings for the
+// settings a strategy type doesn't use.
+func strategyRequiresReplace(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var planned, current []onCallScheduleStrategyModel
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &current, false)...)
+	resp.RequiresReplace = resp.Diagnostics.HasError() || !strategiesEqual(planned, current)
+}
+
+// strategiesEqual compares strategies, treating an empty string and null as the same and
+// ignoring the case of the type and handoff day
+func strategiesEqual(planned, current []onCallScheduleStrategyModel) bool {
+	if len(planned) != len(current) {
+		return false
+	}
+	for i := range planned {
+		if !stringsEqualFold(planned[i].Type, current[i].Type) ||
+			!stringsEqual(planned[i].HandoffTime, current[i].HandoffTime) ||
+			!stringsEqualFold(planned[i].HandoffDay, current[i].HandoffDay) ||
+			!stringsEqual(planned[i].ShiftDuration, current[i].ShiftDuration) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *onCallScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *onCallScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan onCallScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	// Create the on-call schedule
-	teamID := d.Get("team_id").(string)
+	teamID := plan.TeamID.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Create on-call schedule: %s", teamID), map[string]interface{}{
 		"team_id": teamID,
 	})
 
-	inputMemberIDs := d.Get("member_ids").([]interface{})
-	if len(inputMemberIDs) == 0 {
-		inputMemberIDs = d.Get("members").([]interface{})
-	}
-	memberIDs := []string{}
-	for _, memberID := range inputMemberIDs {
-		if v, ok := memberID.(string); ok && v != "" {
-			memberIDs = append(memberIDs, v)
-		}
+	var strategy onCallScheduleStrategyModel
+	if len(plan.Strategy) > 0 {
+		strategy = plan.Strategy[0]
 	}
-
-	// Gather values from API response
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	timeZone := d.Get("time_zone").(string)
-	startTime := d.Get("start_time").(string)
-	handoffTime := d.Get("strategy.0.handoff_time").(string)
-	handoffDay := d.Get("strategy.0.handoff_day").(string)
-	shiftDuration := d.Get("strategy.0.shift_duration").(string)
+	handoffTime := strategy.HandoffTime.ValueString()
+	handoffDay := strings.ToLower(strategy.HandoffDay.ValueString())
+	shiftDuration := strategy.ShiftDuration.ValueString()
 
 	onCallSchedule := components.CreateTeamOnCallSchedule{
-		Name:        name,
-		Description: &description,
-		TimeZone:    &timeZone,
+		Name:        plan.Name.ValueString(),
+		Description: ptr.Of(plan.Description.ValueString()),
+		TimeZone:    ptr.Of(plan.TimeZone.ValueString()),
 		Strategy: &components.CreateTeamOnCallScheduleStrategy{
-			Type:          components.CreateTeamOnCallScheduleType(d.Get("strategy.0.type").(string)),
+			Type:          components.CreateTeamOnCallScheduleType(strings.ToLower(strategy.Type.ValueString())),
 			HandoffTime:   &handoffTime,
 			HandoffDay:    (*components.CreateTeamOnCallScheduleHandoffDay)(&handoffDay),
 			ShiftDuration: &shiftDuration,
 		},
-		MemberIds:    memberIDs,
-		Restrictions: oncallRestrictionsFromDataSDK(d),
+		MemberIds:    plan.memberIDs(ctx),
+		Restrictions: plan.createRestrictions(),
 	}
 
 	// start_time seeds the initial rotation's first shift for every strategy
 	// type, not just custom. The API rejects an empty string, so only send it
 	// when configured.
-	if startTime != "" {
+	if startTime := plan.StartTime.ValueString(); startTime != "" {
 		onCallSchedule.StartTime = &startTime
 	}
 
 	// Get slack_user_group_id if set and non-empty
-	if v, ok := d.GetOk("slack_user_group_id"); ok && v.(string) != "" {
-		slackUserGroupID := v.(string)
+	if slackUserGroupID := plan.SlackUserGroupID.ValueString(); slackUserGroupID != "" {
 		onCallSchedule.SlackUserGroupID = &slackUserGroupID
 	}
 
 	// Optional overrides for the schedule's initial rotation. FireHydrant always
 	// creates one rotation alongside the schedule; without these, that rotation
 	// inherits the schedule's name and description.
-	if v, ok := d.GetOk("rotation_name"); ok && v.(string) != "" {
-		rotationName := v.(string)
+	if rotationName := plan.RotationName.ValueString(); rotationName != "" {
 		onCallSchedule.RotationName = &rotationName
 	}
-	if v, ok := d.GetOk("rotation_description"); ok && v.(string) != "" {
-		rotationDescription := v.(string)
+	if rotationDescription := plan.RotationDescription.ValueString(); rotationDescription != "" {
 		onCallSchedule.RotationDescription = &rotationDescription
 	}
 
@@ -245,10 +468,12 @@ func createResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.Reso
 		isCustomStrategy := onCallSchedule.Strategy.Type == "custom"
 		if isCustomStrategy {
 			if onCallSchedule.Strategy.ShiftDuration == nil || *onCallSchedule.Strategy.ShiftDuration == "" {
-				return diag.Errorf("firehydrant_on_call_schedule.strategy.shift_duration is required when strategy type is 'custom'")
+				resp.Diagnostics.AddError("Missing shift_duration", "firehydrant_on_call_schedule.strategy.shift_duration is required when strategy type is 'custom'")
+				return
 			}
 			if onCallSchedule.StartTime == nil || *onCallSchedule.StartTime == "" {
-				return diag.Errorf("firehydrant_on_call_schedule.start_time is required when strategy type is 'custom'")
+				resp.Diagnostics.AddError("Missing start_time", "firehydrant_on_call_schedule.start_time is required when strategy type is 'custom'")
+				return
 			}
 
 			// Discard unused values to avoid ambiguity.
@@ -256,10 +481,12 @@ func createResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.Reso
 			onCallSchedule.Strategy.HandoffDay = nil
 		} else {
 			if onCallSchedule.Strategy.HandoffTime == nil || *onCallSchedule.Strategy.HandoffTime == "" {
-				return diag.Errorf("firehydrant_on_call_schedule.strategy.handoff_time is required when strategy type is '%s'", onCallSchedule.Strategy.Type)
+				resp.Diagnostics.AddError("Missing handoff_time", fmt.Sprintf("firehydrant_on_call_schedule.strategy.handoff_time is required when strategy type is '%s'", onCallSchedule.Strategy.Type))
+				return
 			}
 			if onCallSchedule.Strategy.Type == "weekly" && (onCallSchedule.Strategy.HandoffDay == nil || *onCallSchedule.Strategy.HandoffDay == "") {
-				return diag.Errorf("firehydrant_on_call_schedule.strategy.handoff_day is required when strategy type is '%s'", onCallSchedule.Strategy.Type)
+				resp.Diagnostics.AddError("Missing handoff_day", fmt.Sprintf("firehydrant_on_call_schedule.strategy.handoff_day is required when strategy type is '%s'", onCallSchedule.Strategy.Type))
+				return
 			}
 
 			// Discard unused values to avoid ambiguity.
@@ -268,270 +495,288 @@ func createResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.Reso
 	}
 
 	// Create the on-call schedule
-	createdOnCallSchedule, err := client.Sdk.Signals.CreateTeamOnCallSchedule(ctx, teamID, onCallSchedule)
+	createdOnCallSchedule, err := r.client.Sdk.Signals.CreateTeamOnCallSchedule(ctx, teamID, onCallSchedule)
 	if err != nil {
-		return diag.Errorf("Error creating on-call schedule %s: %v", teamID, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating on-call schedule %s", teamID), err.Error())
+		return
 	}
 
 	// Set the on-call schedule's ID in state
-	d.SetId(*createdOnCallSchedule.GetID())
-
-	return readResourceFireHydrantOnCallSchedule(ctx, d, m)
+	plan.ID = types.StringPointerValue(createdOnCallSchedule.GetID())
+	r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
 }
 
-func readResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Get the API client
-	client := m.(*firehydrant.APIClient)
+func (r *onCallScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state onCallScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	// Get the on-call schedule
-	id := d.Id()
-	teamID := d.Get("team_id").(string)
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read refreshes the model from the API, reporting false if the schedule no longer exists.
+// Settings the API doesn't return, such as start_time, keep the value they have in model.
+func (r *onCallScheduleResource) read(ctx context.Context, model *onCallScheduleResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id := model.ID.ValueString()
+	teamID := model.TeamID.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Read on-call schedule: %s", id), map[string]interface{}{
 		"id":      id,
 		"team_id": teamID,
 	})
 
-	onCallSchedule, err := client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, id, nil, nil)
+	onCallSchedule, err := r.client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, id, nil, nil)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("On-call schedule %s no longer exists", id), map[string]interface{}{
 				"id":      id,
 				"team_id": teamID,
 			})
-			d.SetId("")
-			return nil
+			return false, nil
 		}
-		return diag.Errorf("Error reading on-call schedule %s: %v", id, err)
+		diags.AddError(fmt.Sprintf("Error reading on-call schedule %s", id), err.Error())
+		return false, diags
 	}
 
 	// Gather values from API response
 	memberIDs := make([]string, len(onCallSchedule.GetMembers()))
 	for i, member := range onCallSchedule.GetMembers() {
-		memberIDs[i] = *member.GetID()
+		memberIDs[i] = stringValue(member.GetID())
 	}
-
-	attributes := map[string]interface{}{
-		"name":         *onCallSchedule.GetName(),
-		"description":  *onCallSchedule.GetDescription(),
-		"time_zone":    *onCallSchedule.GetTimeZone(),
-		"member_ids":   memberIDs,
-		"restrictions": restrictionsToDataSDK(onCallSchedule.GetRestrictions()),
+	// member_ids stays null when it isn't configured, either because the schedule has no
+	// members or because they're set with the deprecated members attribute
+	if model.MemberIDs.IsNull() && (len(memberIDs) == 0 || !model.Members.IsNull()) {
+		model.MemberIDs = types.ListNull(types.StringType)
+	} else {
+		var d diag.Diagnostics
+		model.MemberIDs, d = types.ListValueFrom(ctx, types.StringType, memberIDs)
+		diags.Append(d...)
 	}
 
+	model.ID = types.StringPointerValue(onCallSchedule.GetID())
+	model.Name = types.StringPointerValue(onCallSchedule.GetName())
+	model.Description = types.StringValue(stringValue(onCallSchedule.GetDescription()))
+	model.TimeZone = types.StringPointerValue(onCallSchedule.GetTimeZone())
+	model.Restrictions = restrictionsToModel(onCallSchedule.GetRestrictions(), model.Restrictions)
+
 	// Handle strategy if it exists
 	if strategy := onCallSchedule.GetStrategy(); strategy != nil {
-		attributes["strategy"] = strategyToMapSDK(*strategy)
+		model.Strategy = strategyToModel(*strategy, model.Strategy)
 	}
 	if slackUserGroupID := onCallSchedule.GetSlackUserGroupID(); slackUserGroupID != nil && *slackUserGroupID != "" {
-		attributes["slack_user_group_id"] = *slackUserGroupID
+		model.SlackUserGroupID = types.StringValue(*slackUserGroupID)
 	}
-
 	if rotations := onCallSchedule.GetRotations(); len(rotations) > 0 {
 		if name := rotations[0].GetName(); name != nil {
-			attributes["rotation_name"] = *name
+			model.RotationName = types.StringValue(*name)
 		}
 		if description := rotations[0].GetDescription(); description != nil {
-			attributes["rotation_description"] = *description
+			model.RotationDescription = types.StringValue(*description)
 		}
 	}
-
-	// Set the data source attributes to the values we got from the API
-	for key, val := range attributes {
-		if err := d.Set(key, val); err != nil {
-			return diag.Errorf("Error setting %s for on-call schedule %s: %v", key, id, err)
-		}
+	if model.RotationName.IsUnknown() {
+		model.RotationName = types.StringNull()
+	}
+	if model.RotationDescription.IsUnknown() {
+		model.RotationDescription = types.StringNull()
 	}
 
-	// Set the on-call schedule's ID in state
-	d.SetId(*onCallSchedule.GetID())
-
-	return diag.Diagnostics{}
+	return true, diags
 }
 
-func updateResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Get the API client
-	client := m.(*firehydrant.APIClient)
+func (r *onCallScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state onCallScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	id := d.Id()
-	teamID := d.Get("team_id").(string)
+	// Updating a schedule regenerates its upcoming shifts, so it's skipped when only the way
+	// the schedule is written in state changed, e.g. after it was read by an earlier version
+	// of the provider, or only effective_at changed.
+	if plan.sameSchedule(ctx, state) {
+		r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+
+	id := plan.ID.ValueString()
+	teamID := plan.TeamID.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Update on-call schedule: %s", id), map[string]interface{}{
 		"id":      id,
 		"team_id": teamID,
 	})
 
 	// Initialize updateRequest with basic fields
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-
 	updateRequest := components.UpdateTeamOnCallSchedule{
-		Name:        &name,
-		Description: &description,
+		Name:        ptr.Of(plan.Name.ValueString()),
+		Description: ptr.Of(plan.Description.ValueString()),
+		MemberIds:   plan.memberIDs(ctx),
 	}
 
-	if v, ok := d.GetOk("rotation_name"); ok && v.(string) != "" {
-		rotationName := v.(string)
+	if rotationName := plan.RotationName.ValueString(); rotationName != "" {
 		updateRequest.RotationName = &rotationName
 	}
-	if v, ok := d.GetOk("rotation_description"); ok && v.(string) != "" {
-		rotationDescription := v.(string)
+	if rotationDescription := plan.RotationDescription.ValueString(); rotationDescription != "" {
 		updateRequest.RotationDescription = &rotationDescription
 	}
 
 	// Get slack_user_group_id if set
-	if v, ok := d.GetOk("slack_user_group_id"); ok {
-		slackUserGroupID := v.(string)
+	if slackUserGroupID := plan.SlackUserGroupID.ValueString(); slackUserGroupID != "" {
 		updateRequest.SlackUserGroupID = &slackUserGroupID
 	}
 
 	// Handle effective_at - always set it to ensure API gets a valid timestamp
-	if raw := d.GetRawConfig().GetAttr("effective_at"); !raw.IsNull() {
-		effectiveAtStr := raw.AsString()
-		if effectiveAtStr != "" {
-			// Validate the timestamp format
-			_, err := time.Parse(time.RFC3339, effectiveAtStr)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			// Send the timestamp as-is to the API
-			updateRequest.EffectiveAt = &effectiveAtStr
-			tflog.Debug(ctx, "Schedule update will take effect at: "+effectiveAtStr, map[string]interface{}{
-				"effective_at": effectiveAtStr,
-			})
-		} else {
-			// If effective_at is provided but empty, use current time
-			now := time.Now()
-			effectiveAtStr := now.Format(time.RFC3339)
-			updateRequest.EffectiveAt = &effectiveAtStr
-			tflog.Debug(ctx, "effective_at is empty, using current time for immediate effect", map[string]interface{}{
-				"current_time": effectiveAtStr,
-			})
-		}
+	if effectiveAtStr := plan.EffectiveAt.ValueString(); effectiveAtStr != "" {
+		// Send the timestamp as-is to the API
+		updateRequest.EffectiveAt = &effectiveAtStr
+		tflog.Debug(ctx, "Schedule update will take effect at: "+effectiveAtStr, map[string]interface{}{
+			"effective_at": effectiveAtStr,
+		})
 	} else {
-		// If effective_at is not provided at all, use current time for immediate effect
-		now := time.Now()
-		effectiveAtStr := now.Format(time.RFC3339)
+		// If effective_at is not provided, use current time for immediate effect
+		effectiveAtStr := time.Now().Format(time.RFC3339)
 		updateRequest.EffectiveAt = &effectiveAtStr
 		tflog.Debug(ctx, "effective_at not provided, using current time for immediate effect", map[string]interface{}{
 			"current_time": effectiveAtStr,
 		})
 	}
 
-	// Get member IDs
-	inputMemberIDs := d.Get("member_ids").([]interface{})
-	if len(inputMemberIDs) == 0 {
-		inputMemberIDs = d.Get("members").([]interface{})
-	}
-	memberIDs := []string{}
-	for _, memberID := range inputMemberIDs {
-		if v, ok := memberID.(string); ok && v != "" {
-			memberIDs = append(memberIDs, v)
-		}
-	}
-	updateRequest.MemberIds = memberIDs
-
 	// Get strategy configuration
-	if v, ok := d.GetOk("strategy"); ok {
-		if strategies := v.([]interface{}); len(strategies) > 0 {
-			strategy := strategies[0].(map[string]interface{})
-			strategyType := strategy["type"].(string)
-			handoffTime := strategy["handoff_time"].(string)
-			handoffDay := strategy["handoff_day"].(string)
-
-			updateRequest.Strategy = &components.UpdateTeamOnCallScheduleStrategy{
-				Type:        components.UpdateTeamOnCallScheduleType(strategyType),
-				HandoffTime: &handoffTime,
-				HandoffDay:  (*components.UpdateTeamOnCallScheduleHandoffDay)(&handoffDay),
-			}
+	if len(plan.Strategy) > 0 {
+		strategy := plan.Strategy[0]
+		strategyType := strings.ToLower(strategy.Type.ValueString())
+		handoffTime := strategy.HandoffTime.ValueString()
+		handoffDay := strings.ToLower(strategy.HandoffDay.ValueString())
+
+		updateRequest.Strategy = &components.UpdateTeamOnCallScheduleStrategy{
+			Type:        components.UpdateTeamOnCallScheduleType(strategyType),
+			HandoffTime: &handoffTime,
+			HandoffDay:  (*components.UpdateTeamOnCallScheduleHandoffDay)(&handoffDay),
+		}
 
-			// Set shift duration for custom strategy
-			if strategyType == "custom" {
-				shiftDuration := strategy["shift_duration"].(string)
-				updateRequest.Strategy.ShiftDuration = &shiftDuration
-			}
+		// Set shift duration for custom strategy
+		if strategyType == "custom" {
+			updateRequest.Strategy.ShiftDuration = ptr.Of(strategy.ShiftDuration.ValueString())
 		}
 	}
 
 	// Get restrictions
-	restrictions := d.Get("restrictions").([]interface{})
-	for _, r := range restrictions {
-		restriction := r.(map[string]interface{})
-		startDay := restriction["start_day"].(string)
-		startTime := restriction["start_time"].(string)
-		endDay := restriction["end_day"].(string)
-		endTime := restriction["end_time"].(string)
-
+	for _, restriction := range plan.Restrictions {
 		updateRequest.Restrictions = append(updateRequest.Restrictions, components.UpdateTeamOnCallScheduleRestriction{
-			StartDay:  components.UpdateTeamOnCallScheduleStartDay(startDay),
-			StartTime: startTime,
-			EndDay:    components.UpdateTeamOnCallScheduleEndDay(endDay),
-			EndTime:   endTime,
+			StartDay:  components.UpdateTeamOnCallScheduleStartDay(strings.ToLower(restriction.StartDay.ValueString())),
+			StartTime: restriction.StartTime.ValueString(),
+			EndDay:    components.UpdateTeamOnCallScheduleEndDay(strings.ToLower(restriction.EndDay.ValueString())),
+			EndTime:   restriction.EndTime.ValueString(),
 		})
 	}
 
 	// Update the on-call schedule
-	_, err := client.Sdk.Signals.UpdateTeamOnCallSchedule(ctx, teamID, id, updateRequest)
+	_, err := r.client.Sdk.Signals.UpdateTeamOnCallSchedule(ctx, teamID, id, updateRequest)
 	if err != nil {
-		return diag.Errorf("Error updating on-call schedule %s: %v", id, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating on-call schedule %s", id), err.Error())
+		return
 	}
 
-	return readResourceFireHydrantOnCallSchedule(ctx, d, m)
+	r.readAfterWrite(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// readAfterWrite refreshes the model after it was created or updated and saves it to state
+func (r *onCallScheduleResource) readAfterWrite(ctx context.Context, model *onCallScheduleResourceModel, state stateSetter, respDiags *diag.Diagnostics) {
+	found, diags := r.read(ctx, model)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+	if !found {
+		respDiags.AddError("On-call schedule disappeared", fmt.Sprintf("On-call schedule %s was not found after it was saved", model.ID.ValueString()))
+		return
+	}
+	respDiags.Append(state.Set(ctx, model)...)
 }
 
-func deleteResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Get the API client
-	client := m.(*firehydrant.APIClient)
+func (r *onCallScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state onCallScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	id := d.Id()
-	teamID := d.Get("team_id").(string)
+	id := state.ID.ValueString()
+	teamID := state.TeamID.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Delete on-call schedule: %s", id), map[string]interface{}{
 		"id":      id,
 		"team_id": teamID,
 	})
 
 	// Delete the on-call schedule
-	err := client.Sdk.Signals.DeleteTeamOnCallSchedule(ctx, teamID, id)
+	err := r.client.Sdk.Signals.DeleteTeamOnCallSchedule(ctx, teamID, id)
 	if err != nil {
 		// If the resource is already deleted (404), treat as success
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
-			// Resource already deleted, remove from state
-			d.SetId("")
-			return diag.Diagnostics{}
+		if firehydrant.IsNotFound(err) {
+			return
 		}
 		// If it's a server error during cleanup, check if resource was actually deleted
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode >= 500 {
-			_, readErr := client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, id, nil, nil)
-			if readErr != nil {
-				// If read returns 404, the resource was actually deleted despite the 500 error
-				if readSDKErr, readOk := readErr.(*sdkerrors.SDKError); readOk && readSDKErr.StatusCode == 404 {
-					tflog.Warn(ctx, fmt.Sprintf("On-call schedule %s was deleted despite 500 error during cleanup", id))
-					d.SetId("")
-					return diag.Diagnostics{}
-				}
-				// If read returns another error, fall through to return the original delete error
-			} else {
-				// Resource still exists - the 500 error was real, return it
-				return diag.Errorf("Error deleting on-call schedule %s: %v", id, err)
+		if apiErr, ok := firehydrant.AsError(err); ok && apiErr.StatusCode >= 500 {
+			_, readErr := r.client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, id, nil, nil)
+			// If read returns 404, the resource was actually deleted despite the 500 error.
+			// If read returns another error or the schedule still exists, the original delete
+			// error is returned.
+			if firehydrant.IsNotFound(readErr) {
+				tflog.Warn(ctx, fmt.Sprintf("On-call schedule %s was deleted despite 500 error during cleanup", id))
+				return
 			}
 		}
-		return diag.Errorf("Error deleting on-call schedule %s: %v", id, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting on-call schedule %s", id), err.Error())
 	}
-
-	// Remove the on-call schedule's ID from state
-	d.SetId("")
-
-	return diag.Diagnostics{}
 }
 
-func importResourceFireHydrantOnCallSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	team_id, id, err := resourceFireHydrantOnCallScheduleParseId(d.Id())
+func (r *onCallScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, id, err := resourceFireHydrantOnCallScheduleParseId(req.ID)
 	if err != nil {
-		return nil, err
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	id, err = onCallScheduleImportLookup(teamID).resolve(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing on-call schedule", err.Error())
+		return
 	}
 
-	d.Set("team_id", team_id)
-	d.SetId(id)
-
-	return []*schema.ResourceData{d}, nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func resourceFireHydrantOnCallScheduleParseId(id string) (string, string, error) {
@@ -544,53 +789,132 @@ func resourceFireHydrantOnCallScheduleParseId(id string) (string, string, error)
 	return parts[0], parts[1], nil
 }
 
-func strategyToMapSDK(strategy components.NullableSignalsAPIOnCallStrategyEntity) []map[string]interface{} {
-	m := map[string]interface{}{"type": *strategy.GetType()}
-	if *strategy.GetType() == "custom" {
+// sameSchedule reports whether m configures the same schedule as current, so that updating
+// it would not change anything
+func (m onCallScheduleResourceModel) sameSchedule(ctx context.Context, current onCallScheduleResourceModel) bool {
+	for _, values := range [][2]types.String{
+		{m.Name, current.Name},
+		{m.Description, current.Description},
+		{m.RotationName, current.RotationName},
+		{m.RotationDescription, current.RotationDescription},
+		{m.StartTime, current.StartTime},
+		{m.Color, current.Color},
+		{m.SlackUserGroupID, current.SlackUserGroupID},
+	} {
+		if !stringsEqual(values[0], values[1]) {
+			return false
+		}
+	}
+	if !slices.Equal(m.memberIDs(ctx), current.memberIDs(ctx)) || !strategiesEqual(m.Strategy, current.Strategy) {
+		return false
+	}
+	return slices.EqualFunc(m.Restrictions, current.Restrictions, func(a, b onCallScheduleRestrictionModel) bool {
+		return stringsEqual(a.StartDay, b.StartDay) && stringsEqual(a.StartTime, b.StartTime) &&
+			stringsEqual(a.EndDay, b.EndDay) && stringsEqual(a.EndTime, b.EndTime)
+	})
+}
+
+// memberIDs returns the configured member IDs, taken from the deprecated members attribute
+// when member_ids isn't set
+func (m onCallScheduleResourceModel) memberIDs(ctx context.Context) []string {
+	var inputMemberIDs []string
+	if !m.MemberIDs.IsNull() && !m.MemberIDs.IsUnknown() {
+		m.MemberIDs.ElementsAs(ctx, &inputMemberIDs, false)
+	}
+	if len(inputMemberIDs) == 0 && !m.Members.IsNull() && !m.Members.IsUnknown() {
+		m.Members.ElementsAs(ctx, &inputMemberIDs, false)
+	}
+
+	memberIDs := []string{}
+	for _, memberID := range inputMemberIDs {
+		if memberID != "" {
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+	return memberIDs
+}
+
+func (m onCallScheduleResourceModel) createRestrictions() []components.CreateTeamOnCallScheduleRestriction {
+	restrictions := make([]components.CreateTeamOnCallScheduleRestriction, 0)
+	for _, restriction := range m.Restrictions {
+		restrictions = append(restrictions, components.CreateTeamOnCallScheduleRestriction{
+			StartDay:  components.CreateTeamOnCallScheduleStartDay(strings.ToLower(restriction.StartDay.ValueString())),
+			StartTime: restriction.StartTime.ValueString(),
+			EndDay:    components.CreateTeamOnCallScheduleEndDay(strings.ToLower(restriction.EndDay.ValueString())),
+			EndTime:   restriction.EndTime.ValueString(),
+		})
+	}
+	return restrictions
+}
+
+// strategyToModel returns the strategy the API returned. The settings its type doesn't use
+// keep their current value, and the type and handoff day their current case, so they don't
+// show up as a diff.
+func strategyToModel(strategy components.NullableSignalsAPIOnCallStrategyEntity, current []onCallScheduleStrategyModel) []onCallScheduleStrategyModel {
+	m := onCallScheduleStrategyModel{
+		HandoffTime:   types.StringNull(),
+		HandoffDay:    types.StringNull(),
+		ShiftDuration: types.StringNull(),
+	}
+	if len(current) > 0 {
+		m = current[0]
+	}
+
+	strategyType := stringValue(strategy.GetType())
+	m.Type = keepCase(m.Type, strategyType)
+	if strategyType == "custom" {
 		if shiftDuration := strategy.GetShiftDuration(); shiftDuration != nil {
-			m["shift_duration"] = *shiftDuration
+			m.ShiftDuration = types.StringValue(*shiftDuration)
 		}
 	} else {
 		if handoffTime := strategy.GetHandoffTime(); handoffTime != nil {
-			m["handoff_time"] = *handoffTime
+			m.HandoffTime = types.StringValue(*handoffTime)
 		}
 	}
-	if *strategy.GetType() == "weekly" {
+	if strategyType == "weekly" {
 		if handoffDay := strategy.GetHandoffDay(); handoffDay != nil {
-			m["handoff_day"] = *handoffDay
+			m.HandoffDay = keepCase(m.HandoffDay, *handoffDay)
 		}
 	}
-	return []map[string]interface{}{m}
+	return []onCallScheduleStrategyModel{m}
 }
 
-func oncallRestrictionsFromDataSDK(d *schema.ResourceData) []components.CreateTeamOnCallScheduleRestriction {
-	restrictions := make([]components.CreateTeamOnCallScheduleRestriction, 0)
-	for _, restriction := range d.Get("restrictions").([]interface{}) {
-		restrictionMap := restriction.(map[string]interface{})
-		startDay := restrictionMap["start_day"].(string)
-		startTime := restrictionMap["start_time"].(string)
-		endDay := restrictionMap["end_day"].(string)
-		endTime := restrictionMap["end_time"].(string)
-
-		restrictions = append(restrictions, components.CreateTeamOnCallScheduleRestriction{
-			StartDay:  components.CreateTeamOnCallScheduleStartDay(startDay),
-			StartTime: startTime,
-			EndDay:    components.CreateTeamOnCallScheduleEndDay(endDay),
-			EndTime:   endTime,
-		})
+// restrictionsToModel returns the restrictions the API returned, keeping the case of the days
+// of the current restrictions
+func restrictionsToModel(restrictions []components.SignalsAPIOnCallRestrictionEntity, current []onCallScheduleRestrictionModel) []onCallScheduleRestrictionModel {
+	models := make([]onCallScheduleRestrictionModel, 0, len(restrictions))
+	for i, restriction := range restrictions {
+		m := onCallScheduleRestrictionModel{
+			StartDay:  types.StringPointerValue(restriction.GetStartDay()),
+			StartTime: types.StringPointerValue(restriction.GetStartTime()),
+			EndDay:    types.StringPointerValue(restriction.GetEndDay()),
+			EndTime:   types.StringPointerValue(restriction.GetEndTime()),
+		}
+		if i < len(current) {
+			m.StartDay = keepCase(current[i].StartDay, m.StartDay.ValueString())
+			m.EndDay = keepCase(current[i].EndDay, m.EndDay.ValueString())
+		}
+		models = append(models, m)
 	}
-	return restrictions
+	return models
 }
 
-func restrictionsToDataSDK(restrictions []components.SignalsAPIOnCallRestrictionEntity) []map[string]interface{} {
-	restrictionMaps := make([]map[string]interface{}, 0)
-	for _, restriction := range restrictions {
-		restrictionMaps = append(restrictionMaps, map[string]interface{}{
-			"start_day":  *restriction.GetStartDay(),
-			"start_time": *restriction.GetStartTime(),
-			"end_day":    *restriction.GetEndDay(),
-			"end_time":   *restriction.GetEndTime(),
-		})
+// onCallScheduleImportLookup resolves Team_ID:name=<name> imports within the given team
+func onCallScheduleImportLookup(teamID string) importLookup {
+	return importLookup{
+		resourceName: "on-call schedule",
+		fields:       []string{"name"},
+		list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+			request := operations.ListTeamOnCallSchedulesRequest{TeamID: teamID, Query: &value, PerPage: ptr.Of(importLookupPerPage)}
+			return listImportCandidates(ctx, client, &request,
+				func(request *operations.ListTeamOnCallSchedulesRequest, page *int) { request.Page = page },
+				func(ctx context.Context, request *operations.ListTeamOnCallSchedulesRequest) (pagination.PaginateResponse[components.SignalsAPIOnCallScheduleEntity], error) {
+					return client.Sdk.Signals.ListTeamOnCallSchedules(ctx, *request)
+				},
+				func(schedule components.SignalsAPIOnCallScheduleEntity) importCandidate {
+					return importCandidate{ID: stringValue(schedule.GetID()), Name: stringValue(schedule.GetName())}
+				},
+			)
+		},
 	}
-	return restrictionMaps
 }
@@ -2,21 +2,16 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
 	"time"
 
-	fhsdk "github.com/firehydrant/firehydrant-go-sdk"
-	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -26,70 +21,39 @@ import (
 // beginning of the week. The API never echoes start_time back, so the drop
 // was invisible in state diffs.
 func TestOfflineOnCallScheduleCreate_sendsStartTimeForWeeklyStrategy(t *testing.T) {
-	var createBody map[string]interface{}
-
-	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-
-		if req.Method == "POST" {
-			if err := json.NewDecoder(req.Body).Decode(&createBody); err != nil {
-				t.Errorf("failed to decode create request body: %v", err)
-			}
-			w.WriteHeader(http.StatusCreated)
-		}
-		w.Write([]byte(`{
-  "id": "schedule-id",
-  "name": "test-schedule",
-  "description": "test-description",
-  "time_zone": "America/New_York",
-  "members": [],
-  "strategy": {"type": "weekly", "handoff_time": "10:00:00", "handoff_day": "thursday"},
-  "restrictions": [],
-  "rotations": []
-}`))
-	}))
-	defer ts.Close()
-
-	client := &firehydrant.APIClient{}
-	client.Sdk = fhsdk.New(
-		fhsdk.WithServerURL(ts.URL),
-		fhsdk.WithSecurity(components.Security{
-			APIKey: "test-token-very-authorized",
-		}),
-	)
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
 
 	startTime := "2026-06-15T09:00:00Z"
-	r := schema.TestResourceDataRaw(t, resourceOnCallSchedule().Schema, map[string]interface{}{
-		"team_id":     "team-1",
-		"name":        "test-schedule",
-		"description": "test-description",
-		"time_zone":   "America/New_York",
-		"start_time":  startTime,
-		"strategy": []interface{}{
-			map[string]interface{}{
-				"type":         "weekly",
-				"handoff_time": "10:00:00",
-				"handoff_day":  "thursday",
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + fmt.Sprintf(`
+resource "firehydrant_on_call_schedule" "test_on_call_schedule" {
+  team_id     = %q
+  name        = "test-schedule"
+  description = "test-description"
+  time_zone   = "America/New_York"
+  start_time  = %q
+
+  strategy {
+    type         = "weekly"
+    handoff_time = "10:00:00"
+    handoff_day  = "thursday"
+  }
+}
+`, teamID, startTime),
+				Check: testOfflineOnCallScheduleAPIObject(server, "firehydrant_on_call_schedule.test_on_call_schedule", func(schedule map[string]interface{}) error {
+					if got := schedule["start_time"]; got != startTime {
+						return fmt.Errorf("expected start_time %q in create request body, got %v", startTime, got)
+					}
+					return nil
+				}),
 			},
 		},
 	})
-
-	d := createResourceFireHydrantOnCallSchedule(context.Background(), r, client)
-	if d.HasError() {
-		t.Fatalf("error creating on-call schedule: %v", d)
-	}
-
-	if createBody == nil {
-		t.Fatal("create request body was never captured")
-	}
-
-	got, ok := createBody["start_time"]
-	if !ok {
-		t.Fatalf("start_time missing from create request body; body keys: %v", createBody)
-	}
-	if got != startTime {
-		t.Fatalf("expected start_time %q in create request body, got %q", startTime, got)
-	}
 }
 
 func TestAccOnCallScheduleResource_basic(t *testing.T) {
@@ -98,8 +62,8 @@ func TestAccOnCallScheduleResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 		),
@@ -108,7 +72,7 @@ func TestAccOnCallScheduleResource_basic(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_basic(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_on_call_schedule", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "name", fmt.Sprintf("test-on-call-schedule-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "name", fmt.Sprintf("tf-acc-on-call-schedule-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "strategy.0.type", "weekly"),
@@ -120,7 +84,7 @@ func TestAccOnCallScheduleResource_basic(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_restrictions(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "name", fmt.Sprintf("test-on-call-schedule-restrictions-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "name", fmt.Sprintf("tf-acc-on-call-schedule-restrictions-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "strategy.0.type", "weekly"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "strategy.0.handoff_time", "10:00:00"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule_with_restrictions", "strategy.0.handoff_day", "thursday"),
@@ -138,7 +102,7 @@ func TestAccOnCallScheduleResource_basic(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_customStrategy(rName, sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_custom_schedule", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_custom_schedule", "name", fmt.Sprintf("test-custom-schedule-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_custom_schedule", "name", fmt.Sprintf("tf-acc-custom-schedule-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_custom_schedule", "strategy.0.type", "custom"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_custom_schedule", "strategy.0.shift_duration", "PT8H"),
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_custom_schedule", "start_time"),
@@ -148,11 +112,42 @@ func TestAccOnCallScheduleResource_basic(t *testing.T) {
 	})
 }
 
+func TestOfflineOnCallScheduleResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+
+	testFakeAPICase(t, server, "firehydrant_on_call_schedule", "/v1/teams/{team_id}/on_call_schedules", []resource.TestStep{
+		{
+			Config: testAccOnCallScheduleConfig_basic("offline", teamID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_on_call_schedule", "id"),
+				resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "time_zone", "America/New_York"),
+				resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "strategy.0.handoff_day", "thursday"),
+			),
+		},
+		{
+			Config: testAccOnCallScheduleConfig_basic("renamed", teamID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "name", "tf-acc-on-call-schedule-renamed"),
+				resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "description", "test-description-renamed"),
+			),
+		},
+		{
+			ResourceName:            "firehydrant_on_call_schedule.test_on_call_schedule",
+			ImportStateIdFunc:       testFakeAPIImportStateIDForTeam("firehydrant_on_call_schedule.test_on_call_schedule"),
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"start_time", "effective_at"},
+		},
+	})
+}
+
 func testAccOnCallScheduleConfig_basic(rName, sharedTeamID string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_on_call_schedule" {
 		team_id = "%s"
-		name = "test-on-call-schedule-%s"
+		name = "tf-acc-on-call-schedule-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
@@ -169,7 +164,7 @@ func testAccOnCallScheduleConfig_customStrategy(rName, sharedTeamID string) stri
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_custom_schedule" {
 		team_id = "%s"
-		name = "test-custom-schedule-%s"
+		name = "tf-acc-custom-schedule-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 		start_time = "%s"
@@ -186,7 +181,7 @@ func testAccOnCallScheduleConfig_restrictions(rName, sharedTeamID string) string
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_on_call_schedule_with_restrictions" {
 		team_id = "%s"
-		name = "test-on-call-schedule-restrictions-%s"
+		name = "tf-acc-on-call-schedule-restrictions-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
@@ -222,8 +217,8 @@ func TestAccOnCallScheduleResource_rotationName(t *testing.T) {
 	var initialRotationID string
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 		),
@@ -232,7 +227,7 @@ func TestAccOnCallScheduleResource_rotationName(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_rotationName(rName, sharedTeamID, "Primary", "first rotation under this schedule"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_rotation_name", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_rotation_name", "name", fmt.Sprintf("test-rotation-name-schedule-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_rotation_name", "name", fmt.Sprintf("tf-acc-rotation-name-schedule-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_rotation_name", "rotation_name", "Primary"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_rotation_name", "rotation_description", "first rotation under this schedule"),
 					testAccCheckPrimaryRotation("firehydrant_on_call_schedule.test_rotation_name", "Primary", "first rotation under this schedule", &initialRotationID),
@@ -255,7 +250,7 @@ func testAccOnCallScheduleConfig_rotationName(rName, sharedTeamID, rotationName,
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_rotation_name" {
 		team_id              = "%s"
-		name                 = "test-rotation-name-schedule-%s"
+		name                 = "tf-acc-rotation-name-schedule-%s"
 		description          = "test-description-%s"
 		time_zone            = "America/New_York"
 		rotation_name        = "%s"
@@ -361,170 +356,184 @@ func testAccCheckOnCallScheduleResourceDestroy() resource.TestCheckFunc {
 	}
 }
 
-func offlineOnCallScheduleMockServer() *httptest.Server {
-	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-
-		// Handle different endpoints
-		if req.Method == "GET" {
-			// GET request for reading
-			w.WriteHeader(http.StatusOK)
-			w.Write([]byte(`{
-  "id": "schedule-id",
-  "name": "A pleasant on-call schedule",
-  "description": "Managed by Terraform. Contact @platform-eng for changes.",
-  "members": [
-    {
-      "id": "member-1",
-      "name": "Frederick Graff"
-    }
-  ],
-  "team": {
-    "id": "team-1",
-    "name": "Philadelphia"
-  },
-  "time_zone": "America/New_York"
-}`))
-		} else if req.Method == "POST" {
-			// POST request for creating
-			w.WriteHeader(http.StatusCreated)
-			w.Write([]byte(`{
-  "id": "schedule-id",
-  "name": "A pleasant on-call schedule",
-  "description": "Managed by Terraform. Contact @platform-eng for changes.",
-  "members": [
-    {
-      "id": "member-1",
-      "name": "Frederick Graff"
-    }
-  ],
-  "team": {
-    "id": "team-1",
-    "name": "Philadelphia"
-  },
-  "time_zone": "America/New_York"
-}`))
-		} else {
-			w.WriteHeader(http.StatusMethodNotAllowed)
+// testOfflineOnCallScheduleAPIObject checks the fake API's copy of the on-call schedule
+func testOfflineOnCallScheduleAPIObject(server *fakeapi.Server, name string, check func(schedule map[string]interface{}) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
 		}
-	}))
+		schedule, ok := server.Get(fmt.Sprintf("/v1/teams/%s/on_call_schedules/%s", rs.Primary.Attributes["team_id"], rs.Primary.ID))
+		if !ok {
+			return fmt.Errorf("On-call schedule %s not found", rs.Primary.ID)
+		}
+		return check(schedule)
+	}
 }
 
-func TestOfflineOnCallScheduleReadMemberID(t *testing.T) {
-	ts := offlineOnCallScheduleMockServer()
-	defer ts.Close()
-
-	client := &firehydrant.APIClient{}
-	client.Sdk = fhsdk.New(
-		fhsdk.WithServerURL(ts.URL),
-		fhsdk.WithSecurity(components.Security{
-			APIKey: "test-token-very-authorized",
-		}),
-	)
-
-	r := schema.TestResourceDataRaw(t, resourceOnCallSchedule().Schema, map[string]interface{}{
-		"team_id":     "team-1",
-		"name":        "test-on-call-schedule",
-		"description": "test-description",
-		"time_zone":   "America/New_York",
-		"member_ids":  []interface{}{"member-1"},
+// testOfflineOnCallScheduleMembers checks the members of the fake API's copy of the on-call schedule
+func testOfflineOnCallScheduleMembers(server *fakeapi.Server, name string, memberIDs ...string) resource.TestCheckFunc {
+	return testOfflineOnCallScheduleAPIObject(server, name, func(schedule map[string]interface{}) error {
+		members, _ := schedule["members"].([]interface{})
+		if len(members) != len(memberIDs) {
+			return fmt.Errorf("expected %d members, got %v", len(memberIDs), members)
+		}
+		for i, member := range members {
+			if id := member.(map[string]interface{})["id"]; id != memberIDs[i] {
+				return fmt.Errorf("expected member ID to be %s, got %v", memberIDs[i], id)
+			}
+		}
+		return nil
 	})
+}
 
-	d := readResourceFireHydrantOnCallSchedule(context.Background(), r, client)
-	if d.HasError() {
-		t.Fatalf("error reading on-call schedule: %v", d)
-	}
+func testOfflineOnCallScheduleMembersConfig(teamID, attribute string) string {
+	return fmt.Sprintf(`
+resource "firehydrant_on_call_schedule" "test_on_call_schedule" {
+  team_id     = %q
+  name        = "test-on-call-schedule"
+  description = "test-description"
+  time_zone   = "America/New_York"
+  %s = ["member-1"]
+
+  strategy {
+    type         = "weekly"
+    handoff_time = "10:00:00"
+    handoff_day  = "thursday"
+  }
+}
+`, teamID, attribute)
+}
 
-	memberIDs := r.Get("member_ids").([]interface{})
-	if len(memberIDs) != 1 {
-		t.Fatalf("expected 1 member ID, got %d", len(memberIDs))
-	}
+func TestOfflineOnCallScheduleReadMemberID(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Philadelphia"})[0]["id"].(string)
 
-	memberID, ok := memberIDs[0].(string)
-	if !ok {
-		t.Fatalf("expected member ID to be a string, got %T: %v", memberIDs[0], memberIDs[0])
-	}
-	if memberID != "member-1" {
-		t.Fatalf("expected member ID to be member-1, got %s", memberIDs[0].(string))
-	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + testOfflineOnCallScheduleMembersConfig(teamID, "member_ids"),
+			},
+			{
+				ResourceName:      "firehydrant_on_call_schedule.test_on_call_schedule",
+				ImportStateIdFunc: testFakeAPIImportStateIDForTeam("firehydrant_on_call_schedule.test_on_call_schedule"),
+				ImportState:       true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported on-call schedule, got %d", len(states))
+					}
+					if got := states[0].Attributes["member_ids.#"]; got != "1" {
+						return fmt.Errorf("expected 1 member ID, got %s", got)
+					}
+					if got := states[0].Attributes["member_ids.0"]; got != "member-1" {
+						return fmt.Errorf("expected member ID to be member-1, got %s", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
 }
 
 func TestOfflineOnCallScheduleCreate(t *testing.T) {
-	ts := offlineOnCallScheduleMockServer()
-	defer ts.Close()
-
-	client := &firehydrant.APIClient{}
-	client.Sdk = fhsdk.New(
-		fhsdk.WithServerURL(ts.URL),
-		fhsdk.WithSecurity(components.Security{
-			APIKey: "test-token-very-authorized",
-		}),
-	)
-
-	r := schema.TestResourceDataRaw(t, resourceOnCallSchedule().Schema, map[string]interface{}{
-		"team_id":     "team-1",
-		"name":        "test-on-call-schedule",
-		"description": "test-description",
-		"time_zone":   "America/New_York",
-		"member_ids":  []interface{}{"member-1"},
-	})
-
-	d := createResourceFireHydrantOnCallSchedule(context.Background(), r, client)
-	if d.HasError() {
-		t.Fatalf("error creating on-call schedule: %v", d)
-	}
-
-	memberIDs := r.Get("member_ids").([]interface{})
-	if len(memberIDs) != 1 {
-		t.Fatalf("expected 1 member ID, got %d", len(memberIDs))
-	}
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Philadelphia"})[0]["id"].(string)
 
-	memberID, ok := memberIDs[0].(string)
-	if !ok {
-		t.Fatalf("expected member ID to be a string, got %T: %v", memberIDs[0], memberIDs[0])
-	}
-	if memberID != "member-1" {
-		t.Fatalf("expected member ID to be member-1, got %s", memberIDs[0].(string))
-	}
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + testOfflineOnCallScheduleMembersConfig(teamID, "member_ids"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "member_ids.#", "1"),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "member_ids.0", "member-1"),
+					testOfflineOnCallScheduleMembers(server, "firehydrant_on_call_schedule.test_on_call_schedule", "member-1"),
+				),
+			},
+		},
+	})
 }
 
 // Deprecated, but ensure it still works until we officially remove support.
 func TestOfflineOnCallScheduleCreateDeprecated(t *testing.T) {
-	ts := offlineOnCallScheduleMockServer()
-	defer ts.Close()
-
-	client := &firehydrant.APIClient{}
-	client.Sdk = fhsdk.New(
-		fhsdk.WithServerURL(ts.URL),
-		fhsdk.WithSecurity(components.Security{
-			APIKey: "test-token-very-authorized",
-		}),
-	)
-
-	r := schema.TestResourceDataRaw(t, resourceOnCallSchedule().Schema, map[string]interface{}{
-		"team_id":     "team-1",
-		"name":        "test-on-call-schedule",
-		"description": "test-description",
-		"time_zone":   "America/New_York",
-		"members":     []interface{}{"member-1"},
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Philadelphia"})[0]["id"].(string)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + testOfflineOnCallScheduleMembersConfig(teamID, "members"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "members.#", "1"),
+					resource.TestCheckNoResourceAttr("firehydrant_on_call_schedule.test_on_call_schedule", "member_ids.#"),
+					testOfflineOnCallScheduleMembers(server, "firehydrant_on_call_schedule.test_on_call_schedule", "member-1"),
+				),
+			},
+		},
 	})
+}
 
-	d := createResourceFireHydrantOnCallSchedule(context.Background(), r, client)
-	if d.HasError() {
-		t.Fatalf("error creating on-call schedule: %v", d)
+func TestOnCallScheduleUpgradeStateV0(t *testing.T) {
+	state := testUpgradeResourceState(t, "firehydrant_on_call_schedule", 0, `{
+		"id": "schedule-1",
+		"team_id": "team-1",
+		"name": "test-on-call-schedule",
+		"description": "",
+		"members": ["member-1", "member-2"],
+		"member_ids": ["member-1", "member-2"],
+		"time_zone": "America/New_York",
+		"start_time": "",
+		"color": "",
+		"slack_user_group_id": "",
+		"effective_at": "",
+		"strategy": [{"type": "weekly", "handoff_time": "10:00:00", "handoff_day": "thursday", "shift_duration": ""}],
+		"restrictions": [{"start_day": "Monday", "start_time": "09:00", "end_day": "monday", "end_time": "17:00:00"}],
+		"timeouts": null
+	}`)
+
+	var members []tftypes.Value
+	if err := state["members"].As(&members); err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 || !members[0].Equal(tftypes.NewValue(tftypes.String, "member-1")) || !members[1].Equal(tftypes.NewValue(tftypes.String, "member-2")) {
+		t.Errorf("expected members to be kept, got %v", members)
+	}
+	if !state["member_ids"].IsNull() {
+		t.Errorf("expected member_ids to be null when members is set, got %v", state["member_ids"])
 	}
 
-	memberIDs := r.Get("member_ids").([]interface{})
-	if len(memberIDs) != 1 {
-		t.Fatalf("expected 1 member ID, got %d", len(memberIDs))
+	var strategies []tftypes.Value
+	if err := state["strategy"].As(&strategies); err != nil {
+		t.Fatal(err)
+	}
+	var strategy map[string]tftypes.Value
+	if err := strategies[0].As(&strategy); err != nil {
+		t.Fatal(err)
+	}
+	if !strategy["shift_duration"].IsNull() {
+		t.Errorf("expected the unused shift_duration to be null, got %v", strategy["shift_duration"])
+	}
+	if !strategy["handoff_day"].Equal(tftypes.NewValue(tftypes.String, "thursday")) {
+		t.Errorf("expected handoff_day to be kept, got %v", strategy["handoff_day"])
 	}
 
-	memberID, ok := memberIDs[0].(string)
-	if !ok {
-		t.Fatalf("expected member ID to be a string, got %T: %v", memberIDs[0], memberIDs[0])
+	var restrictions []tftypes.Value
+	if err := state["restrictions"].As(&restrictions); err != nil {
+		t.Fatal(err)
 	}
-	if memberID != "member-1" {
-		t.Fatalf("expected member ID to be member-1, got %s", memberIDs[0].(string))
+	var restriction map[string]tftypes.Value
+	if err := restrictions[0].As(&restriction); err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range map[string]string{"start_day": "monday", "start_time": "09:00:00", "end_day": "monday", "end_time": "17:00:00"} {
+		if !restriction[name].Equal(tftypes.NewValue(tftypes.String, expected)) {
+			t.Errorf("expected restriction %s to be %s, got %v", name, expected, restriction[name])
+		}
 	}
 }
 
@@ -534,8 +543,8 @@ func TestAccOnCallScheduleResource_updateHandoffAndRestrictions(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 		),
@@ -545,7 +554,7 @@ func TestAccOnCallScheduleResource_updateHandoffAndRestrictions(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_withHandoff(rName, "monday", "09:00:00", sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_schedule", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "name", fmt.Sprintf("test-schedule-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "name", fmt.Sprintf("tf-acc-schedule-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "slack_user_group_id", "test-group-1"),
 					// Strategy settings
@@ -561,7 +570,7 @@ func TestAccOnCallScheduleResource_updateHandoffAndRestrictions(t *testing.T) {
 				Config: testAccOnCallScheduleConfig_withHandoffAndRestrictions(rName, "wednesday", "13:00:00", sharedTeamID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_on_call_schedule.test_schedule", "id"),
-					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "name", fmt.Sprintf("test-schedule-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "name", fmt.Sprintf("tf-acc-schedule-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("firehydrant_on_call_schedule.test_schedule", "slack_user_group_id", "test-group-1"),
 					// Changed handoff settings
@@ -600,7 +609,7 @@ func testAccOnCallScheduleConfig_withHandoff(rName, handoffDay, handoffTime, sha
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = "%s"
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -617,7 +626,7 @@ func testAccOnCallScheduleConfig_withHandoffAndRestrictions(rName, handoffDay, h
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = "%s"
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -650,8 +659,8 @@ func TestAccOnCallScheduleResource_scheduleModifications(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckOnCallScheduleResourceDestroy(),
 		),
@@ -711,7 +720,7 @@ func testAccOnCallScheduleConfig_withBusinessHours(rName, handoffDay, handoffTim
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = "%s"
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -739,9 +748,9 @@ func TestAccOnCallScheduleResource_effectiveAt(t *testing.T) {
 	pastTime := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)  // Yesterday
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckOnCallScheduleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckOnCallScheduleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Initial schedule setup
@@ -792,7 +801,7 @@ func testAccOnCallScheduleConfig_withEffectiveAt(rName, handoffDay, handoffTime,
 	return fmt.Sprintf(`
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = "%s"
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -815,9 +824,9 @@ func TestAccOnCallScheduleResourceImport_basic(t *testing.T) {
 	resourceName := "firehydrant_on_call_schedule.test_on_call_schedule"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckOnCallScheduleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckOnCallScheduleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOnCallScheduleConfig_basic(rName, sharedTeamID),
@@ -21,11 +21,11 @@ func TestOnCallSchedulesData(t *testing.T) {
 func (s *testOnCallSchedulesDataSuite) terraform(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_on_call_schedule" "schedule_1" {
-  name        = "test-on-call-schedule-%s"
+  name        = "tf-acc-on-call-schedule-%s"
   description = "test-description"
   team_id     = firehydrant_team.team.id
   time_zone   = "America/Los_Angeles"
@@ -49,16 +49,16 @@ resource "firehydrant_on_call_schedule" "schedule_1" {
 
 data "firehydrant_on_call_schedules" "schedules" {
 	team_id = firehydrant_team.team.id
-	query = "test-on-call-schedule-%s"
+	query = "tf-acc-on-call-schedule-%s"
 	depends_on = [firehydrant_on_call_schedule.schedule_1]
 }`, rName, rName, rName)
 }
 
 func (s *testOnCallSchedulesDataSuite) testResource(steps ...resource.TestStep) {
 	resource.Test(s.T(), resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(s.T()) },
-		ProviderFactories: sharedProviderFactories(),
-		Steps:             steps,
+		PreCheck:                 func() { testFireHydrantIsSetup(s.T()) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		Steps:                    steps,
 	})
 }
 
@@ -75,7 +75,7 @@ func (s *testOnCallSchedulesDataSuite) TestMatches() {
 			resource.TestCheckResourceAttrSet("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.#"),
 			resource.TestCheckResourceAttrSet("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.id"),
 			resource.TestCheckResourceAttrSet("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.team_id"),
-			resource.TestCheckResourceAttr("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.name", fmt.Sprintf("test-on-call-schedule-%s", rName)),
+			resource.TestCheckResourceAttr("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.name", fmt.Sprintf("tf-acc-on-call-schedule-%s", rName)),
 			resource.TestCheckResourceAttr("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.description", "test-description"),
 			resource.TestCheckResourceAttr("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.time_zone", "America/Los_Angeles"),
 			resource.TestCheckResourceAttr("data.firehydrant_on_call_schedules.schedules", "on_call_schedules.0.slack_user_group_id", "test-slack-user-group-id"),
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -21,8 +20,9 @@ func resourcePriority() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantPriority,
 		ReadContext:   readResourceFireHydrantPriority,
 		DeleteContext: deleteResourceFireHydrantPriority,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: priorityImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -71,7 +71,7 @@ func readResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceData
 	})
 	response, err := client.Sdk.IncidentSettings.GetPriority(ctx, priorityID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Priority %s no longer exists", priorityID), map[string]interface{}{
 				"id": priorityID,
 			})
@@ -168,7 +168,7 @@ func deleteResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceDa
 	})
 	err := client.Sdk.IncidentSettings.DeletePriority(ctx, priorityID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting priority %s: %v", priorityID, err)
@@ -176,3 +176,10 @@ func deleteResourceFireHydrantPriority(ctx context.Context, d *schema.ResourceDa
 
 	return diag.Diagnostics{}
 }
+
+// Priorities are identified by their slug, so there is nothing to look up
+var priorityImportLookup = importLookup{
+	resourceName: "priority",
+	fields:       []string{"slug"},
+	slugIsID:     true,
+}
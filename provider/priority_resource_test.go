@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,9 +18,9 @@ func TestAccPriorityResource_basic(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckPriorityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckPriorityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPriorityResourceConfig_basic(rSlug),
@@ -42,9 +43,9 @@ func TestAccPriorityResource_update(t *testing.T) {
 	rSlugUpdated := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckPriorityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckPriorityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPriorityResourceConfig_basic(rSlug),
@@ -90,8 +91,8 @@ func TestAccPriorityResource_validateSchemaAttributesSlug(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccPriorityResourceConfig_slugTooLong(rSlug),
@@ -110,8 +111,8 @@ func TestAccPriorityResourceImport_basic(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPriorityResourceConfig_basic(rSlug),
@@ -130,8 +131,8 @@ func TestAccPriorityResourceImport_allAttributes(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccPriorityResourceConfig_update(rSlug),
@@ -145,6 +146,32 @@ func TestAccPriorityResourceImport_allAttributes(t *testing.T) {
 	})
 }
 
+func TestOfflinePriorityResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	testFakeAPICase(t, server, "firehydrant_priority", "/v1/priorities", []resource.TestStep{
+		{
+			Config: testAccPriorityResourceConfig_basic("OFFLINE"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_priority.test_priority", "id", "TESTPRIORITYOFFLINE"),
+			),
+		},
+		{
+			Config: testAccPriorityResourceConfig_update("OFFLINE"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_priority.test_priority", "description", "test-description-OFFLINE"),
+				resource.TestCheckResourceAttr("firehydrant_priority.test_priority", "default", "false"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_priority.test_priority",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccCheckPriorityResourceExistsWithAttributes_basic(resourceSlug string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		priorityResource, ok := s.RootModule().Resources[resourceSlug]
@@ -3,34 +3,138 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	apiKeyName             = "api_key"
 	firehydrantBaseURLName = "firehydrant_base_url"
+	maxRetriesName         = "max_retries"
+	retryMaxWaitName       = "retry_max_wait"
+	requestsPerSecondName  = "requests_per_second"
+	proxyURLName           = "proxy_url"
+	caCertPEMName          = "ca_cert_pem"
+	caCertFileName         = "ca_cert_file"
+	clientCertName         = "client_cert"
+	clientKeyName          = "client_key"
+	insecureSkipVerifyName = "insecure_skip_verify"
+	readCacheName          = "read_cache"
 )
 
-// Provider returns a terraform provider for the FireHydrant API
+// Provider returns the plugin SDK provider for the FireHydrant API, which serves the resources
+// and data sources that haven't moved to the plugin framework yet. Terraform uses it through
+// NewServer.
 func Provider() *schema.Provider {
+	return newProvider(&configuredClient{})
+}
+
+func newProvider(client *configuredClient) *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			apiKeyName: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_API_KEY", nil),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{apiKeyFileName, apiKeyCommandName},
+				Description:   "The API key used to manage resources in FireHydrant. Defaults to the FIREHYDRANT_API_KEY environment variable.",
+			},
+			apiKeyFileName: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{apiKeyName, apiKeyCommandName},
+				Description:   "Path to a file containing the API key. Defaults to the FIREHYDRANT_API_KEY_FILE environment variable.",
+			},
+			apiKeyCommandName: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{apiKeyName, apiKeyFileName},
+				Description:   "A command and its arguments that print the API key to stdout. It runs once per provider process.",
 			},
 			firehydrantBaseURLName: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_BASE_URL", "https://api.firehydrant.io/v1/"),
+				DefaultFunc: schema.EnvDefaultFunc("FIREHYDRANT_BASE_URL", defaultBaseURL),
+			},
+			maxRetriesName: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      firehydrant.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times a rate limited or failed API request is retried.",
+			},
+			retryMaxWaitName: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          firehydrant.DefaultRetryMaxWait.String(),
+				ValidateDiagFunc: validateDuration,
+				Description:      "The longest the provider waits between two attempts of an API request, as a Go duration (e.g. \"30s\").",
+			},
+			requestsPerSecondName: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FIREHYDRANT_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum number of API requests per second shared by all resources and data sources. Zero disables client side rate limiting.",
+			},
+			proxyURLName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FIREHYDRANT_PROXY_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "The URL of an HTTP, HTTPS or SOCKS5 proxy all API requests are sent through. Defaults to the proxy set by the HTTPS_PROXY and NO_PROXY environment variables.",
+			},
+			caCertPEMName: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{caCertFileName},
+				Description:   "PEM encoded certificate authorities trusted in addition to the system's, e.g. for a proxy that re-signs TLS connections.",
+			},
+			caCertFileName: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("FIREHYDRANT_CA_CERT_FILE", nil),
+				ConflictsWith: []string{caCertPEMName},
+				Description:   "Path to a file of PEM encoded certificate authorities trusted in addition to the system's.",
+			},
+			clientCertName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{clientKeyName},
+				Description:  "PEM encoded client certificate presented when the API or proxy requires mutual TLS.",
+			},
+			clientKeyName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{clientCertName},
+				Description:  "PEM encoded private key of the client certificate.",
+			},
+			insecureSkipVerifyName: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables verification of the API's TLS certificate. Only use this for testing.",
+			},
+			readCacheName: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Caches the API responses of data source lookups, such as users, teams and services, for the lifetime of the provider, and sends identical concurrent lookups only once.",
+			},
+			defaultLabelsName: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels added to every resource that has labels. Labels set on a resource take precedence.",
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
@@ -49,38 +153,39 @@ func Provider() *schema.Provider {
 			"firehydrant_task_list":              resourceTaskList(),
 			"firehydrant_team":                   resourceTeam(),
 			"firehydrant_signal_rule":            resourceSignalRule(),
-			"firehydrant_on_call_schedule":       resourceOnCallSchedule(),
-			"firehydrant_escalation_policy":      resourceEscalationPolicy(),
 			"firehydrant_status_update_template": resourceStatusUpdateTemplate(),
 			"firehydrant_inbound_email":          resourceInboundEmail(),
 			"firehydrant_custom_event_source":    resourceCustomEventSource(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"firehydrant_environment":       dataSourceEnvironment(),
-			"firehydrant_functionality":     dataSourceFunctionality(),
-			"firehydrant_escalation_policy": dataSourceEscalationPolicy(),
-			"firehydrant_incident_role":     dataSourceIncidentRole(),
-			"firehydrant_incident_type":     dataSourceIncidentType(),
-			"firehydrant_ingest_url":        dataSourceIngestURL(),
-			"firehydrant_lifecycle_phase":   dataSourceLifecyclePhase(),
-			"firehydrant_on_call_schedule":  dataSourceOnCallSchedule(),
-			"firehydrant_on_call_schedules": dataSourceOnCallSchedules(),
-			"firehydrant_priority":          dataSourcePriority(),
-			"firehydrant_role":              dataSourceRole(),
-			"firehydrant_rotation":          dataSourceRotation(),
-			"firehydrant_runbook":           dataSourceRunbook(),
-			"firehydrant_runbook_action":    dataSourceRunbookAction(),
-			"firehydrant_schedule":          dataSourceSchedule(),
-			"firehydrant_service":           dataSourceService(),
-			"firehydrant_services":          dataSourceServices(),
-			"firehydrant_severity":          dataSourceSeverity(),
-			"firehydrant_signal_rule":       dataSourceSignalRule(),
-			"firehydrant_slack_channel":     dataSourceSlackChannel(),
-			"firehydrant_task_list":         dataSourceTaskList(),
-			"firehydrant_team":              dataSourceTeam(),
-			"firehydrant_teams":             dataSourceTeams(),
-			"firehydrant_user":              dataSourceUser(),
-			"firehydrant_permissions":       dataSourcePermissions(),
+			"firehydrant_environment":              dataSourceEnvironment(),
+			"firehydrant_functionality":            dataSourceFunctionality(),
+			"firehydrant_escalation_policy":        dataSourceEscalationPolicy(),
+			"firehydrant_incident_role":            dataSourceIncidentRole(),
+			"firehydrant_incident_type":            dataSourceIncidentType(),
+			"firehydrant_ingest_url":               dataSourceIngestURL(),
+			"firehydrant_lifecycle_phase":          dataSourceLifecyclePhase(),
+			"firehydrant_on_call_schedule":         dataSourceOnCallSchedule(),
+			"firehydrant_on_call_schedule_preview": dataSourceOnCallSchedulePreview(),
+			"firehydrant_on_call_schedules":        dataSourceOnCallSchedules(),
+			"firehydrant_priority":                 dataSourcePriority(),
+			"firehydrant_role":                     dataSourceRole(),
+			"firehydrant_rotation":                 dataSourceRotation(),
+			"firehydrant_runbook":                  dataSourceRunbook(),
+			"firehydrant_runbook_action":           dataSourceRunbookAction(),
+			"firehydrant_schedule":                 dataSourceSchedule(),
+			"firehydrant_schedule_coverage":        dataSourceScheduleCoverage(),
+			"firehydrant_service":                  dataSourceService(),
+			"firehydrant_services":                 dataSourceServices(),
+			"firehydrant_severity":                 dataSourceSeverity(),
+			"firehydrant_signal_rule":              dataSourceSignalRule(),
+			"firehydrant_signal_rule_evaluation":   dataSourceSignalRuleEvaluation(),
+			"firehydrant_slack_channel":            dataSourceSlackChannel(),
+			"firehydrant_task_list":                dataSourceTaskList(),
+			"firehydrant_team":                     dataSourceTeam(),
+			"firehydrant_teams":                    dataSourceTeams(),
+			"firehydrant_user":                     dataSourceUser(),
+			"firehydrant_permissions":              dataSourcePermissions(),
 		},
 	}
 
@@ -91,41 +196,30 @@ func Provider() *schema.Provider {
 			terraformVersion = "unknown"
 		}
 
-		return setupFireHydrantContext(ctx, rd, terraformVersion)
+		ac, err := client.get(ctx, providerConfigFromResourceData(rd), terraformVersion)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		return ac, nil
 	}
 
 	return provider
 }
 
-func setupFireHydrantContext(ctx context.Context, rd *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
-	apiKey := rd.Get(apiKeyName).(string)
-	fireHydrantBaseURL := rd.Get(firehydrantBaseURLName).(string)
-
-	// Add minimal delay between provider initializations in CI to avoid rate limiting
-	if os.Getenv("TF_ACC") != "" {
-		time.Sleep(500 * time.Millisecond)
-	}
-
-	ac, err := firehydrant.NewRestClient(apiKey, firehydrant.WithBaseURL(fireHydrantBaseURL), firehydrant.WithUserAgentSuffix(fmt.Sprintf("terraform-%s", terraformVersion)))
-	if err != nil {
-		return nil, diag.FromErr(fmt.Errorf("could not initialize API client: %w", err))
-	}
-
-	_, err = ac.Ping(ctx)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	// We're getting 429s during tests, so the var here is intended to reduce overall API calls.  The old provider, horrifyingly, seems
-	// to do part of its setup during this Ping() call, so we won't disable that one, but just cutting the pings in half should be sufficient.
-	if os.Getenv("TF_ACC") == "" {
-		_, err = ac.Sdk.AccountSettings.Ping(ctx)
-		if err != nil {
-			return nil, diag.FromErr(err)
+func validateDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	value := v.(string)
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid duration",
+				Detail:        fmt.Sprintf("expected a positive duration such as \"30s\" or \"2m\", got: %s", value),
+				AttributePath: path,
+			},
 		}
 	}
 
-	return ac, nil
+	return nil
 }
 
 func convertStringMap(sm map[string]interface{}) map[string]string {
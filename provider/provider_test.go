@@ -2,21 +2,36 @@ package provider
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestMain(m *testing.M) {
-	// os.Exit skips deferred functions, so all setup/cleanup lives in
+	// resource.TestMain runs the sweepers when -sweep is given and the tests
+	// otherwise. os.Exit skips deferred functions, so all setup/cleanup lives in
 	// testMain where defers run before the exit code is returned.
-	os.Exit(testMain(m))
+	resource.TestMain(testMainRunner{m})
+}
+
+// testMainRunner runs the tests through testMain for resource.TestMain
+type testMainRunner struct {
+	m *testing.M
+}
+
+func (r testMainRunner) Run() int {
+	return testMain(r.m)
 }
 
 func testMain(m *testing.M) int {
@@ -55,12 +70,99 @@ func testMain(m *testing.M) int {
 	return m.Run()
 }
 
-func defaultProviderFactories() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"firehydrant": func() (*schema.Provider, error) {
-			return Provider(), nil
-		},
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("Provider is invalid: %s", err.Error())
+	}
+}
+
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+	server, err := NewServer(ctx)
+	if err != nil {
+		t.Fatalf("Provider server is invalid: %s", err)
+	}
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	for name := range Provider().ResourcesMap {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("%s is missing from the provider server", name)
+		}
+	}
+	for _, name := range []string{"firehydrant_escalation_policy", "firehydrant_on_call_schedule"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("%s is missing from the provider server", name)
+		}
+	}
+	if _, ok := resp.EphemeralResourceSchemas["firehydrant_ingest_url"]; !ok {
+		t.Error("firehydrant_ingest_url ephemeral resource is missing from the provider server")
+	}
+}
+
+func TestResourceTimeouts(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		timeouts := r.Timeouts
+		if timeouts == nil || timeouts.Create == nil || timeouts.Read == nil || timeouts.Update == nil || timeouts.Delete == nil {
+			t.Errorf("%s does not declare create, read, update and delete timeouts", name)
+		}
 	}
+
+	ctx := context.Background()
+	p := newFrameworkProvider(&configuredClient{})
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metadata fwresource.MetadataResponse
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "firehydrant"}, &metadata)
+		var resp fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+		block, ok := resp.Schema.Blocks["timeouts"]
+		if !ok {
+			t.Errorf("%s does not declare timeouts", metadata.TypeName)
+			continue
+		}
+		for _, name := range []string{"create", "read", "update", "delete"} {
+			if _, ok := block.GetNestedObject().GetAttributes()[name]; !ok {
+				t.Errorf("%s does not declare a %s timeout", metadata.TypeName, name)
+			}
+		}
+	}
+}
+
+func TestOfflineProviderCACertFile(t *testing.T) {
+	server := fakeapi.NewTLSServer()
+	defer server.Close()
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caCertFile, caCertPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testFakeAPIProviderConfig(server) + testAccTeamResourceConfig_basic("offline"),
+				ExpectError: regexp.MustCompile(`certificate`),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "firehydrant" {
+  api_key              = "test-token-very-authorized"
+  firehydrant_base_url = %q
+  ca_cert_file         = %q
+  max_retries          = 0
+}
+`, server.BaseURL(), caCertFile) + testAccTeamResourceConfig_basic("offline"),
+				Check: resource.TestCheckResourceAttr("firehydrant_team.test_team", "name", "tf-acc-team-offline"),
+			},
+		},
+	})
 }
 
 func TestAccService(t *testing.T) {
@@ -69,15 +171,15 @@ func TestAccService(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testServiceDoesNotExist("firehydrant_service.terraform-acceptance-test-service"),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testServiceDoesNotExist("firehydrant_service.terraform-acceptance-test-service"),
 		Steps: []resource.TestStep{
 			{
 				Config: testServiceConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
-					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "name", fmt.Sprintf("test-service-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "description", fmt.Sprintf("%s description", rName)),
 				),
 			},
@@ -85,14 +187,14 @@ func TestAccService(t *testing.T) {
 				Config: testServiceConfig(rNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
 					testServiceExists("firehydrant_service.terraform-acceptance-test-service"),
-					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr("firehydrant_service.terraform-acceptance-test-service", "description", fmt.Sprintf("%s description", rNameUpdated)),
 				),
 			},
 			{
 				Config: testServiceDataSourceConfig(rNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr("data.firehydrant_services.services", "services.0.service_tier", "5"),
 				),
 			},
@@ -108,7 +210,7 @@ func testFireHydrantIsSetup(t *testing.T) {
 
 const testServiceConfigTemplate = `
 resource "firehydrant_service" "terraform-acceptance-test-service" {
-	name = "test-service-%s"
+	name = "tf-acc-service-%s"
 	description = "%s description"
 	labels = {
 		key1 = "value1"
@@ -123,7 +225,7 @@ func testServiceDataSourceConfig(rName string) string {
 
 const testServiceDataSourceConfigTemplate = `
 resource "firehydrant_service" "terraform-acceptance-test-service" {
-	name = "test-service-%s"
+	name = "tf-acc-service-%s"
 	description = "%s description"
 	labels = {
 		key1 = "value1"
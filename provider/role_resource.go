@@ -2,11 +2,13 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +20,9 @@ func resourceRole() *schema.Resource {
 		ReadContext:   readResourceFireHydrantRole,
 		UpdateContext: updateResourceFireHydrantRole,
 		DeleteContext: deleteResourceFireHydrantRole,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: roleImportLookup.importState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -128,7 +131,7 @@ func readResourceFireHydrantRole(ctx context.Context, d *schema.ResourceData, m
 
 	role, err := client.Sdk.Roles.GetRole(ctx, id)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, "Role not found, removing from state", map[string]interface{}{
 				"id": id,
 			})
@@ -215,6 +218,9 @@ func deleteResourceFireHydrantRole(ctx context.Context, d *schema.ResourceData,
 
 	err := client.Sdk.Roles.DeleteRole(ctx, id)
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			return nil
+		}
 		return diag.Errorf("Error deleting role %s: %v", id, err)
 	}
 
@@ -230,3 +236,23 @@ func convertStringSliceToInterface(strings []string) []interface{} {
 	}
 	return interfaces
 }
+
+var roleImportLookup = importLookup{
+	resourceName: "role",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListRolesRequest{PerPage: ptr.Of(importLookupPerPage)}
+		if field == "name" {
+			request.Query = &value
+		}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListRolesRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListRolesRequest) (pagination.PaginateResponse[components.PublicAPIV1RoleEntity], error) {
+				return client.Sdk.Roles.ListRoles(ctx, request.Query, request.Page, request.PerPage)
+			},
+			func(role components.PublicAPIV1RoleEntity) importCandidate {
+				return importCandidate{ID: stringValue(role.GetID()), Name: stringValue(role.GetName()), Slug: stringValue(role.GetSlug())}
+			},
+		)
+	},
+}
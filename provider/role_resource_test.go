@@ -15,15 +15,15 @@ func TestAccRoleResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRoleConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_role.test_role", "id"),
-					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("test-role-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("tf-acc-role-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_role.test_role", "description", "Test role for Terraform"),
 					resource.TestCheckResourceAttrSet("firehydrant_role.test_role", "slug"),
 
@@ -36,7 +36,7 @@ func TestAccRoleResource_basic(t *testing.T) {
 				Config: testAccRoleConfig_updated(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_role.test_role", "id"),
-					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("updated-role-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("tf-acc-updated-role-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_role.test_role", "description", "Updated test role"),
 				),
 			},
@@ -49,15 +49,15 @@ func TestAccRoleResource_withPermissions(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRoleResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRoleResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRoleConfig_withPermissions(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_role.test_role", "id"),
-					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("test-role-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_role.test_role", "name", fmt.Sprintf("tf-acc-role-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_role.test_role", "permissions.#", "20"),
 				),
 			},
@@ -102,7 +102,7 @@ func testAccCheckRoleResourceDestroy() resource.TestCheckFunc {
 func testAccRoleConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_role" "test_role" {
-		name        = "test-role-%s"
+		name        = "tf-acc-role-%s"
 		description = "Test role for Terraform"
 		permissions = [
 			"read_users"
@@ -114,7 +114,7 @@ func testAccRoleConfig_basic(rName string) string {
 func testAccRoleConfig_updated(rName string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_role" "test_role" {
-		name        = "updated-role-%s"
+		name        = "tf-acc-updated-role-%s"
 		description = "Updated test role"
 		permissions = [
 			"read_users"
@@ -126,7 +126,7 @@ func testAccRoleConfig_updated(rName string) string {
 func testAccRoleConfig_withPermissions(rName string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_role" "test_role" {
-		name        = "test-role-%s"
+		name        = "tf-acc-role-%s"
 		description = "Test role with permissions"
 		permissions = [
 			"read_alerts",
@@ -157,7 +157,7 @@ func testAccRoleConfig_withPermissions(rName string) string {
 func testAccRoleConfig_withUpdatedPermissions(rName string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_role" "test_role" {
-		name        = "test-role-%s"
+		name        = "tf-acc-role-%s"
 		description = "Test role with updated permissions"
 		permissions = [
 			"read_alerts",
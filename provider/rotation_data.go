@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -64,7 +63,7 @@ func dataFireHydrantRotation(ctx context.Context, d *schema.ResourceData, m inte
 	})
 	rotation, err := client.Sdk.Signals.GetOnCallScheduleRotation(ctx, id, teamID, scheduleID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return diag.Errorf("Rotation %s not found", id)
 		}
 		return diag.Errorf("Error reading rotation %s for schedule %s for team %s: %v", id, scheduleID, teamID, err)
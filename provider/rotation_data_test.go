@@ -21,11 +21,11 @@ func TestRotationData(t *testing.T) {
 func (s *testRotationDataSuite) terraform(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_rotation_data_team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 }
 
 resource "firehydrant_on_call_schedule" "test_on_call_schedule_data_1" {
-  name        = "test-on-call-schedule-%s"
+  name        = "tf-acc-on-call-schedule-%s"
   description = "test-description"
   team_id     = firehydrant_team.test_rotation_data_team.id
   time_zone   = "America/Los_Angeles"
@@ -47,7 +47,7 @@ resource "firehydrant_on_call_schedule" "test_on_call_schedule_data_1" {
 }
 
 resource "firehydrant_rotation" "test_rotation_data_1" {
-  name = "test-rotation-%s"
+  name = "tf-acc-rotation-%s"
 	description = "test-description"
 	team_id = firehydrant_team.test_rotation_data_team.id
 	schedule_id = firehydrant_on_call_schedule.test_on_call_schedule_data_1.id
@@ -80,9 +80,9 @@ data "firehydrant_rotation" "test_rotation_data" {
 
 func (s *testRotationDataSuite) testResource(steps ...resource.TestStep) {
 	resource.Test(s.T(), resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(s.T()) },
-		ProviderFactories: sharedProviderFactories(),
-		Steps:             steps,
+		PreCheck:                 func() { testFireHydrantIsSetup(s.T()) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		Steps:                    steps,
 	})
 }
 
@@ -96,7 +96,7 @@ func (s *testRotationDataSuite) TestSuccess() {
 			resource.TestCheckResourceAttrSet("data.firehydrant_rotation.test_rotation_data", "id"),
 			resource.TestCheckResourceAttrSet("data.firehydrant_rotation.test_rotation_data", "team_id"),
 			resource.TestCheckResourceAttrSet("data.firehydrant_rotation.test_rotation_data", "schedule_id"),
-			resource.TestCheckResourceAttr("data.firehydrant_rotation.test_rotation_data", "name", fmt.Sprintf("test-rotation-%s", rName)),
+			resource.TestCheckResourceAttr("data.firehydrant_rotation.test_rotation_data", "name", fmt.Sprintf("tf-acc-rotation-%s", rName)),
 			resource.TestCheckResourceAttr("data.firehydrant_rotation.test_rotation_data", "description", "test-description"),
 			resource.TestCheckResourceAttr("data.firehydrant_rotation.test_rotation_data", "time_zone", "America/Los_Angeles"),
 			resource.TestCheckResourceAttr("data.firehydrant_rotation.test_rotation_data", "slack_user_group_id", "test-slack-user-group-id"),
@@ -7,13 +7,13 @@ import (
 	"time"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceRotation() *schema.Resource {
@@ -22,156 +22,315 @@ func resourceRotation() *schema.Resource {
 		ReadContext:   readResourceFireHydrantRotation,
 		UpdateContext: updateResourceFireHydrantRotation,
 		DeleteContext: deleteResourceFireHydrantRotation,
+		CustomizeDiff: customizeDiffRotation,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: importResourceFireHydrantRotation,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"team_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: rotationSchemaV0()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceFireHydrantRotationStateUpgradeV0,
 			},
-			"schedule_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"time_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"slack_user_group_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"enable_slack_channel_notifications": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"prevent_shift_deletion": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"coverage_gap_notification_interval": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"color": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"members": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"user_id": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The ID of the user to add to the rotation. You can use the firehydrant_user data source to look up a user by email/name. Leave empty to create an unassigned slot in the rotation.",
-						},
+		},
+		Schema: rotationSchema(),
+	}
+}
+
+func rotationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"team_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"schedule_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateWith(checkTimeZone),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"slack_user_group_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"enable_slack_channel_notifications": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"prevent_shift_deletion": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"coverage_gap_notification_interval": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"color": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"members": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The ID of the user to add to the rotation. You can use the firehydrant_user data source to look up a user by email/name. Leave empty to create an unassigned slot in the rotation.",
 					},
 				},
 			},
-			"strategy": {
-				Type:     schema.TypeList, // Using TypeList to simulate a map
-				Required: true,
-				ForceNew: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"handoff_time": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"handoff_day": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"shift_duration": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
+		},
+		"strategy": {
+			Type:     schema.TypeList, // Using TypeList to simulate a map
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(rotationStrategyTypes, true),
+						StateFunc:    lowercase,
+					},
+					"handoff_time": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
+					},
+					"handoff_day": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkWeekday),
+						StateFunc:    lowercase,
+					},
+					"shift_duration": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWith(checkShiftDuration),
 					},
 				},
 			},
-			"restrictions": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"start_day": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"start_time": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"end_day": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"end_time": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
+		},
+		"restrictions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(weekdays, true),
+						StateFunc:    lowercase,
+					},
+					"start_time": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
+					},
+					"end_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(weekdays, true),
+						StateFunc:    lowercase,
+					},
+					"end_time": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateWith(checkTimeOfDay),
 					},
 				},
 			},
-			"start_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"effective_at": {
-				Type:     schema.TypeString,
-				Optional: true,
-				// Don't set computed:true since we don't want it in the state
-				Description: "RFC3339 timestamp for when the schedule update should take effect. If not provided or if the time is in the past, the update will take effect immediately.",
-				ValidateDiagFunc: schema.SchemaValidateDiagFunc(
-					func(v interface{}, path cty.Path) diag.Diagnostics {
-						timeStr := v.(string)
-						_, err := time.Parse(time.RFC3339, timeStr)
-						if err != nil {
-							return diag.Diagnostics{
-								diag.Diagnostic{
-									Severity:      diag.Error,
-									Summary:       "Invalid effective_at timestamp",
-									Detail:        fmt.Sprintf("effective_at must be a valid RFC3339 timestamp (e.g. 2024-01-01T15:04:05Z), got: %s", timeStr),
-									AttributePath: path,
-								},
-							}
+		},
+		"start_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"effective_at": {
+			Type:     schema.TypeString,
+			Optional: true,
+			// Don't set computed:true since we don't want it in the state
+			Description: "RFC3339 timestamp for when the schedule update should take effect. If not provided or if the time is in the past, the update will take effect immediately.",
+			ValidateDiagFunc: schema.SchemaValidateDiagFunc(
+				func(v interface{}, path cty.Path) diag.Diagnostics {
+					timeStr := v.(string)
+					_, err := time.Parse(time.RFC3339, timeStr)
+					if err != nil {
+						return diag.Diagnostics{
+							diag.Diagnostic{
+								Severity:      diag.Error,
+								Summary:       "Invalid effective_at timestamp",
+								Detail:        fmt.Sprintf("effective_at must be a valid RFC3339 timestamp (e.g. 2024-01-01T15:04:05Z), got: %s", timeStr),
+								AttributePath: path,
+							},
 						}
+					}
 
-						return nil
-					},
-				),
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return true
+					return nil
+				},
+			),
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return true
+			},
+		},
+	}
+}
+
+// customizeDiffRotation checks the strategy and restriction settings that depend on each
+// other, once their values are known
+func customizeDiffRotation(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	strategyKeys := []string{"strategy.0.type", "strategy.0.handoff_time", "strategy.0.handoff_day", "strategy.0.shift_duration", "start_time"}
+	if newValuesKnown(d, strategyKeys...) {
+		err := checkRotationStrategy(
+			d.Get("strategy.0.type").(string),
+			d.Get("strategy.0.handoff_time").(string),
+			d.Get("strategy.0.handoff_day").(string),
+			d.Get("strategy.0.shift_duration").(string),
+			d.Get("start_time").(string),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("restrictions") {
+		return nil
+	}
+	var restrictions []rotationRestriction
+	for i, r := range d.Get("restrictions").([]interface{}) {
+		prefix := fmt.Sprintf("restrictions.%d.", i)
+		if !newValuesKnown(d, prefix+"start_day", prefix+"start_time", prefix+"end_day", prefix+"end_time") {
+			return nil
+		}
+		restriction := r.(map[string]interface{})
+		restrictions = append(restrictions, rotationRestriction{
+			startDay:  restriction["start_day"].(string),
+			startTime: restriction["start_time"].(string),
+			endDay:    restriction["end_day"].(string),
+			endTime:   restriction["end_time"].(string),
+		})
+	}
+	return checkRestrictionWindows(restrictions)
+}
+
+// newValuesKnown reports whether the planned values of all of the keys are known
+func newValuesKnown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// rotationSchemaV0 is the rotation's schema at version 0, kept as it was so that changes to
+// rotationSchema don't change how version 0 state is decoded.
+func rotationSchemaV0() map[string]*schema.Schema {
+	optionalString := func() *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true}
+	}
+	requiredString := func() *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Required: true}
+	}
+
+	return map[string]*schema.Schema{
+		"team_id":                            requiredString(),
+		"schedule_id":                        requiredString(),
+		"name":                               requiredString(),
+		"time_zone":                          requiredString(),
+		"description":                        optionalString(),
+		"slack_user_group_id":                optionalString(),
+		"enable_slack_channel_notifications": {Type: schema.TypeBool, Optional: true},
+		"prevent_shift_deletion":             {Type: schema.TypeBool, Optional: true},
+		"coverage_gap_notification_interval": optionalString(),
+		"color":                              optionalString(),
+		"members": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": optionalString(),
+				},
+			},
+		},
+		"strategy": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type":           requiredString(),
+					"handoff_time":   optionalString(),
+					"handoff_day":    optionalString(),
+					"shift_duration": optionalString(),
+				},
+			},
+		},
+		"restrictions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_day":  requiredString(),
+					"start_time": requiredString(),
+					"end_day":    requiredString(),
+					"end_time":   requiredString(),
 				},
 			},
 		},
+		"start_time":   optionalString(),
+		"effective_at": optionalString(),
 	}
 }
 
+// resourceFireHydrantRotationStateUpgradeV0 normalizes the restrictions to the form the API
+// returns them in
+func resourceFireHydrantRotationStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	if restrictions, ok := rawState["restrictions"].([]interface{}); ok {
+		for _, restriction := range restrictions {
+			restrictionMap, ok := restriction.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, key := range []string{"start_day", "end_day"} {
+				if day, ok := restrictionMap[key].(string); ok {
+					restrictionMap[key] = normalizeRestrictionDay(day)
+				}
+			}
+			for _, key := range []string{"start_time", "end_time"} {
+				if t, ok := restrictionMap[key].(string); ok {
+					restrictionMap[key] = normalizeRestrictionTime(t)
+				}
+			}
+		}
+	}
+
+	return rawState, nil
+}
+
 func readResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*firehydrant.APIClient)
 
@@ -186,7 +345,7 @@ func readResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceData
 
 	rotation, err := client.Sdk.Signals.GetOnCallScheduleRotation(ctx, id, teamID, scheduleID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, "Rotation %s does not exist", map[string]interface{}{
 				"id":          id,
 				"team_id":     teamID,
@@ -308,9 +467,9 @@ func createResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceDa
 	// Gather values from schema
 	name := d.Get("name").(string)
 	timeZone := d.Get("time_zone").(string)
-	strategyType := d.Get("strategy.0.type").(string)
+	strategyType := strings.ToLower(d.Get("strategy.0.type").(string))
 	handoffTime := d.Get("strategy.0.handoff_time").(string)
-	handoffDay := d.Get("strategy.0.handoff_day").(string)
+	handoffDay := strings.ToLower(d.Get("strategy.0.handoff_day").(string))
 	shiftDuration := d.Get("strategy.0.shift_duration").(string)
 
 	rotation := components.CreateOnCallScheduleRotation{
@@ -535,9 +694,9 @@ func updateResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceDa
 	if v, ok := d.GetOk("strategy"); ok {
 		if strategies := v.([]interface{}); len(strategies) > 0 {
 			strategy := strategies[0].(map[string]interface{})
-			strategyType := strategy["type"].(string)
+			strategyType := strings.ToLower(strategy["type"].(string))
 			handoffTime := strategy["handoff_time"].(string)
-			handoffDay := strategy["handoff_day"].(string)
+			handoffDay := strings.ToLower(strategy["handoff_day"].(string))
 			shiftDuration := strategy["shift_duration"].(string)
 
 			updateStrategy := &components.UpdateOnCallScheduleRotationStrategy{
@@ -567,9 +726,9 @@ func updateResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceDa
 	updateRequest.Restrictions = make([]components.UpdateOnCallScheduleRotationRestriction, 0, len(restrictions))
 	for _, r := range restrictions {
 		restriction := r.(map[string]interface{})
-		startDay := restriction["start_day"].(string)
+		startDay := strings.ToLower(restriction["start_day"].(string))
 		startTime := restriction["start_time"].(string)
-		endDay := restriction["end_day"].(string)
+		endDay := strings.ToLower(restriction["end_day"].(string))
 		endTime := restriction["end_time"].(string)
 
 		updateRequest.Restrictions = append(updateRequest.Restrictions, components.UpdateOnCallScheduleRotationRestriction{
@@ -603,7 +762,7 @@ func deleteResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceDa
 	err := client.Sdk.Signals.DeleteOnCallScheduleRotation(ctx, id, teamID, scheduleID)
 	if err != nil {
 		// If the resource is already deleted (404), treat as success
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting rotation %s: %v", id, err)
@@ -619,6 +778,10 @@ func importResourceFireHydrantRotation(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return nil, err
 	}
+	id, err = rotationImportLookup(team_id, schedule_id).resolve(ctx, m.(*firehydrant.APIClient), id)
+	if err != nil {
+		return nil, err
+	}
 
 	d.Set("team_id", team_id)
 	d.Set("schedule_id", schedule_id)
@@ -637,6 +800,25 @@ func resourceFireHydrantRotationParseId(id string) (string, string, string, erro
 	return parts[0], parts[1], parts[2], nil
 }
 
+// rotationImportLookup resolves Team_ID:Schedule_ID:name=<name> imports within the given schedule
+func rotationImportLookup(teamID, scheduleID string) importLookup {
+	return importLookup{
+		resourceName: "rotation",
+		fields:       []string{"name"},
+		list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+			schedule, err := client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, scheduleID, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			candidates := make([]importCandidate, 0, len(schedule.GetRotations()))
+			for _, rotation := range schedule.GetRotations() {
+				candidates = append(candidates, importCandidate{ID: stringValue(rotation.GetID()), Name: stringValue(rotation.GetName())})
+			}
+			return candidates, nil
+		},
+	}
+}
+
 func rotationStrategyToMapSDK(strategy components.NullableSignalsAPIOnCallStrategyEntity) []map[string]interface{} {
 	m := map[string]interface{}{"type": *strategy.GetType()}
 	if *strategy.GetType() == "custom" {
@@ -660,9 +842,9 @@ func rotationRestrictionsFromDataSDK(d *schema.ResourceData) []components.Create
 	restrictions := make([]components.CreateOnCallScheduleRotationRestriction, 0)
 	for _, restriction := range d.Get("restrictions").([]interface{}) {
 		restrictionMap := restriction.(map[string]interface{})
-		startDay := restrictionMap["start_day"].(string)
+		startDay := strings.ToLower(restrictionMap["start_day"].(string))
 		startTime := restrictionMap["start_time"].(string)
-		endDay := restrictionMap["end_day"].(string)
+		endDay := strings.ToLower(restrictionMap["end_day"].(string))
 		endTime := restrictionMap["end_time"].(string)
 
 		restrictions = append(restrictions, components.CreateOnCallScheduleRotationRestriction{
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
 
 	"regexp"
@@ -34,15 +35,15 @@ func TestAccRotationResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRotationConfig_basic(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("test-rotation-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("tf-acc-rotation-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "strategy.0.type", "weekly"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "strategy.0.handoff_time", "10:00:00"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "strategy.0.handoff_day", "thursday"),
@@ -52,7 +53,7 @@ func TestAccRotationResource_basic(t *testing.T) {
 				Config: testAccRotationConfig_restrictions(rName, sharedTeamID, sharedScheduleID),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_with_restrictions", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_with_restrictions", "name", fmt.Sprintf("test-rotation-restrictions-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_with_restrictions", "name", fmt.Sprintf("tf-acc-rotation-restrictions-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_with_restrictions", "strategy.0.type", "weekly"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_with_restrictions", "strategy.0.handoff_time", "10:00:00"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_with_restrictions", "strategy.0.handoff_day", "thursday"),
@@ -75,7 +76,7 @@ func testAccRotationConfig_basic(rName, sharedTeamID, sharedScheduleID string) s
 	resource "firehydrant_rotation" "test_rotation" {
 	  team_id = "%s"
 		schedule_id = "%s"
-		name = "test-rotation-%s"
+		name = "tf-acc-rotation-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
@@ -97,7 +98,7 @@ func testAccRotationConfig_restrictions(rName, sharedTeamID, sharedScheduleID st
 	resource "firehydrant_rotation" "test_rotation_with_restrictions" {
 	  team_id = "%s"
 		schedule_id = "%s"
-		name = "test-rotation-restrictions-%s"
+		name = "tf-acc-rotation-restrictions-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
@@ -276,6 +277,29 @@ func TestOfflineRotationReadMemberID(t *testing.T) {
 	}
 }
 
+func TestRotationStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":          "rotation-id",
+		"team_id":     "team-1",
+		"schedule_id": "schedule-1",
+		"restrictions": []interface{}{
+			map[string]interface{}{"start_day": "Monday", "start_time": "09:00", "end_day": "FRIDAY", "end_time": "17:00:00"},
+		},
+	}
+
+	state, err := resourceFireHydrantRotationStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error upgrading rotation state: %v", err)
+	}
+
+	expectedRestrictions := []interface{}{
+		map[string]interface{}{"start_day": "monday", "start_time": "09:00:00", "end_day": "friday", "end_time": "17:00:00"},
+	}
+	if !reflect.DeepEqual(state["restrictions"], expectedRestrictions) {
+		t.Fatalf("expected restrictions to be %v, got %v", expectedRestrictions, state["restrictions"])
+	}
+}
+
 func TestOfflineRotationCreate(t *testing.T) {
 	ts := offlineRotationMockServer()
 	defer ts.Close()
@@ -407,16 +431,16 @@ func TestAccRotationResource_updateHandoffAndRestrictions(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Initial configuration
 				Config: testAccRotationConfig_withHandoff(rName, "monday", "09:00:00"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("test-rotation-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("tf-acc-rotation-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "slack_user_group_id", "test-group-1"),
 					// Strategy settings
@@ -432,7 +456,7 @@ func TestAccRotationResource_updateHandoffAndRestrictions(t *testing.T) {
 				Config: testAccRotationConfig_withHandoffAndRestrictions(rName, "wednesday", "13:00:00"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("test-rotation-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "name", fmt.Sprintf("tf-acc-rotation-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "time_zone", "America/New_York"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation", "slack_user_group_id", "test-group-1"),
 					// Changed handoff settings
@@ -470,12 +494,12 @@ func TestAccRotationResource_updateHandoffAndRestrictions(t *testing.T) {
 func testAccRotationConfig_withHandoff(rName, handoffDay, handoffTime string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_team" "test_team" {
-		name = "test-team-%s"
+		name = "tf-acc-team-%s"
 	}
 
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = firehydrant_team.test_team.id
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -489,7 +513,7 @@ func testAccRotationConfig_withHandoff(rName, handoffDay, handoffTime string) st
 	resource "firehydrant_rotation" "test_rotation" {
 		team_id = firehydrant_team.test_team.id
 		schedule_id = firehydrant_on_call_schedule.test_schedule.id
-		name = "test-rotation-%s"
+		name = "tf-acc-rotation-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 		color = "#3192ff"
@@ -506,12 +530,12 @@ func testAccRotationConfig_withHandoff(rName, handoffDay, handoffTime string) st
 func testAccRotationConfig_withHandoffAndRestrictions(rName, handoffDay, handoffTime string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_team" "test_team" {
-		name = "test-team-%s"
+		name = "tf-acc-team-%s"
 	}
 
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = firehydrant_team.test_team.id
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -525,7 +549,7 @@ func testAccRotationConfig_withHandoffAndRestrictions(rName, handoffDay, handoff
 	resource "firehydrant_rotation" "test_rotation" {
 		team_id = firehydrant_team.test_team.id
 		schedule_id = firehydrant_on_call_schedule.test_schedule.id
-		name = "test-rotation-%s"
+		name = "tf-acc-rotation-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 		color = "#3192ff"
@@ -557,9 +581,9 @@ func TestAccRotationResource_scheduleModifications(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Initial configuration with restrictions
@@ -614,12 +638,12 @@ func TestAccRotationResource_scheduleModifications(t *testing.T) {
 func testAccRotationConfig_withBusinessHours(rName, handoffDay, handoffTime string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_team" "test_team" {
-		name = "test-team-%s"
+		name = "tf-acc-team-%s"
 	}
 
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = firehydrant_team.test_team.id
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -633,7 +657,7 @@ func testAccRotationConfig_withBusinessHours(rName, handoffDay, handoffTime stri
 	resource "firehydrant_rotation" "test_rotation" {
 		team_id = firehydrant_team.test_team.id
 		schedule_id = firehydrant_on_call_schedule.test_schedule.id
-		name = "test-rotation-%s"
+		name = "tf-acc-rotation-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 		color = "#3192ff"
@@ -660,9 +684,9 @@ func TestAccRotationResource_effectiveAt(t *testing.T) {
 	pastTime := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)  // Yesterday
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Initial schedule setup
@@ -712,12 +736,12 @@ func TestAccRotationResource_effectiveAt(t *testing.T) {
 func testAccRotationConfig_withEffectiveAt(rName, handoffDay, handoffTime, effectiveAt string) string {
 	return fmt.Sprintf(`
 	resource "firehydrant_team" "test_team" {
-		name = "test-team-%s"
+		name = "tf-acc-team-%s"
 	}
 
 	resource "firehydrant_on_call_schedule" "test_schedule" {
 		team_id = firehydrant_team.test_team.id
-		name = "test-schedule-%s"
+		name = "tf-acc-schedule-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 
@@ -731,7 +755,7 @@ func testAccRotationConfig_withEffectiveAt(rName, handoffDay, handoffTime, effec
 	resource "firehydrant_rotation" "test_rotation" {
 		team_id = firehydrant_team.test_team.id
 		schedule_id = firehydrant_on_call_schedule.test_schedule.id
-		name = "test-rotation-%s"
+		name = "tf-acc-rotation-%s"
 		time_zone = "America/New_York"
 		slack_user_group_id = "test-group-1"
 		color = "#3192ff"
@@ -755,9 +779,9 @@ func TestAccRotationResourceImport_basic(t *testing.T) {
 	resourceName := "firehydrant_rotation.test_rotation"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRotationConfig_basic(rName, sharedTeamID, sharedScheduleID),
@@ -789,16 +813,16 @@ func TestAccRotationResource_members(t *testing.T) {
 	// Get a second user - use the same user for simplicity, but in real scenarios would be different
 	// The API allows the same user to be added multiple times
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Step 1: Create rotation with 2 members
 				Config: testAccRotationConfig_withTwoMembers(rName, sharedTeamID, sharedScheduleID, existingUser),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_members", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "name", fmt.Sprintf("test-rotation-members-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "name", fmt.Sprintf("tf-acc-rotation-members-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "members.#", "2"),
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_members", "members.0.user_id"),
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_members", "members.1.user_id"),
@@ -838,16 +862,16 @@ func TestAccRotationResource_membersWithUnassignedSlot(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRotationResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRotationResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				// Step 1: Create rotation with a member, unassigned slot, and another member
 				Config: testAccRotationConfig_withUnassignedSlot(rName, sharedTeamID, sharedScheduleID, existingUser),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_members", "id"),
-					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "name", fmt.Sprintf("test-rotation-members-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "name", fmt.Sprintf("tf-acc-rotation-members-%s", rName)),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "members.#", "3"),
 					resource.TestCheckResourceAttrSet("firehydrant_rotation.test_rotation_members", "members.0.user_id"),
 					resource.TestCheckResourceAttr("firehydrant_rotation.test_rotation_members", "members.1.user_id", ""), // Unassigned slot
@@ -872,7 +896,7 @@ func testAccRotationConfig_withTwoMembers(rName, sharedTeamID, sharedScheduleID,
 	resource "firehydrant_rotation" "test_rotation_members" {
 	  team_id = "%s"
 		schedule_id = "%s"
-		name = "test-rotation-members-%s"
+		name = "tf-acc-rotation-members-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
@@ -906,7 +930,7 @@ func testAccRotationConfig_withUnassignedSlot(rName, sharedTeamID, sharedSchedul
 	resource "firehydrant_rotation" "test_rotation_members" {
 	  team_id = "%s"
 		schedule_id = "%s"
-		name = "test-rotation-members-%s"
+		name = "tf-acc-rotation-members-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
@@ -949,7 +973,7 @@ func testAccRotationConfig_withMember(rName, sharedTeamID, sharedScheduleID, use
 	resource "firehydrant_rotation" "test_rotation_members" {
 	  team_id = "%s"
 		schedule_id = "%s"
-		name = "test-rotation-members-%s"
+		name = "tf-acc-rotation-members-%s"
 		description = "test-description-%s"
 		time_zone = "America/New_York"
 
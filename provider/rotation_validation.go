@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/senseyeio/duration"
+)
+
+var (
+	// rotationStrategyTypes are the strategy types of rotations and on-call schedules
+	rotationStrategyTypes = []string{"daily", "weekly", "custom"}
+	// weekdays are the days of handoffs and restrictions, as the API returns them. Like the
+	// strategy types, the API accepts them in any case.
+	weekdays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+)
+
+// timeOfDay matches the HH:MM:SS times of handoffs and restrictions
+var timeOfDay = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`)
+
+// checkTimeOfDay checks that s is a time of day in HH:MM:SS format
+func checkTimeOfDay(s string) error {
+	if !timeOfDay.MatchString(s) {
+		return fmt.Errorf("expected a time of day in HH:MM:SS format, e.g. 09:00:00, got %q", s)
+	}
+	return nil
+}
+
+// checkWeekday checks that s is a day of the week, in any case
+func checkWeekday(s string) error {
+	if !slices.Contains(weekdays, strings.ToLower(s)) {
+		return fmt.Errorf("expected a day of the week, e.g. monday, got %q", s)
+	}
+	return nil
+}
+
+// checkShiftDuration checks that s is an ISO8601 duration longer than zero
+func checkShiftDuration(s string) error {
+	d, err := duration.ParseISO8601(s)
+	if err != nil {
+		return fmt.Errorf("expected an ISO8601 duration, e.g. PT12H, got %q", s)
+	}
+	if now := time.Now(); !d.Shift(now).After(now) {
+		return fmt.Errorf("expected a duration longer than zero, got %q", s)
+	}
+	return nil
+}
+
+// checkTimeZone checks that s is the name of an IANA time zone
+func checkTimeZone(s string) error {
+	if s == "" || s == "Local" {
+		return fmt.Errorf("expected an IANA time zone, e.g. America/New_York, got %q", s)
+	}
+	if _, err := time.LoadLocation(s); err != nil {
+		return fmt.Errorf("expected an IANA time zone, e.g. America/New_York, got %q: %v", s, err)
+	}
+	return nil
+}
+
+// checkRotationStrategy checks that a strategy has the settings its type requires
+func checkRotationStrategy(strategyType, handoffTime, handoffDay, shiftDuration, startTime string) error {
+	strategyType = strings.ToLower(strategyType)
+	switch strategyType {
+	case "custom":
+		if shiftDuration == "" {
+			return fmt.Errorf("strategy.shift_duration is required when strategy type is 'custom'")
+		}
+		if startTime == "" {
+			return fmt.Errorf("start_time is required when strategy type is 'custom'")
+		}
+	case "daily", "weekly":
+		if handoffTime == "" {
+			return fmt.Errorf("strategy.handoff_time is required when strategy type is '%s'", strategyType)
+		}
+		if strategyType == "weekly" && handoffDay == "" {
+			return fmt.Errorf("strategy.handoff_day is required when strategy type is '%s'", strategyType)
+		}
+	}
+	return nil
+}
+
+// checkRestrictionWindows checks that no restriction is empty and that no two restrictions
+// overlap. Restrictions repeat weekly, so one that wraps into the next week can overlap one
+// early in the week.
+func checkRestrictionWindows(restrictions []rotationRestriction) error {
+	// Any week will do, this one starts on Sunday, 1 January 2023
+	weekStart := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	windows := make([]timeRange, 0, len(restrictions))
+	for i, restriction := range restrictions {
+		if strings.EqualFold(restriction.startDay, restriction.endDay) && restriction.startTime == restriction.endTime {
+			return fmt.Errorf("restrictions.%d starts and ends at the same time", i)
+		}
+		window, err := restriction.in(weekStart)
+		if err != nil {
+			return fmt.Errorf("restrictions.%d: %w", i, err)
+		}
+		windows = append(windows, window)
+	}
+
+	week := 7 * 24 * time.Hour
+	for i := range windows {
+		for j := i + 1; j < len(windows); j++ {
+			for _, offset := range []time.Duration{-week, 0, week} {
+				start, end := windows[j].start.Add(offset), windows[j].end.Add(offset)
+				if windows[i].start.Before(end) && start.Before(windows[i].end) {
+					return fmt.Errorf("restrictions.%d overlaps restrictions.%d", i, j)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// lowercase is a plugin SDK StateFunc for values the API doesn't distinguish by case, which
+// stores them the way the API returns them so that configurations in another case show no diff
+func lowercase(v interface{}) string {
+	s, _ := v.(string)
+	return strings.ToLower(s)
+}
+
+// validateWith adapts a check to a plugin SDK validation function. Empty values are left to
+// Required and to checks of the settings that depend on each other.
+func validateWith(check func(string) error) schema.SchemaValidateFunc {
+	return func(v interface{}, key string) ([]string, []error) {
+		s, _ := v.(string)
+		if s == "" {
+			return nil, nil
+		}
+		if err := check(s); err != nil {
+			return nil, []error{fmt.Errorf("%s: %w", key, err)}
+		}
+		return nil, nil
+	}
+}
+
+// checkValidator adapts a check to a plugin framework string validator. Like validateWith,
+// it leaves empty values alone.
+type checkValidator struct {
+	description string
+	check       func(string) error
+}
+
+var _ validator.String = checkValidator{}
+
+func (v checkValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v checkValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v checkValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, fmt.Sprintf("Invalid %s", req.Path), err.Error())
+	}
+}
+
+var (
+	timeOfDayValidator     = checkValidator{description: "value must be a time of day in HH:MM:SS format", check: checkTimeOfDay}
+	weekdayValidator       = checkValidator{description: "value must be a day of the week", check: checkWeekday}
+	shiftDurationValidator = checkValidator{description: "value must be an ISO8601 duration", check: checkShiftDuration}
+	timeZoneValidator      = checkValidator{description: "value must be an IANA time zone", check: checkTimeZone}
+)
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCheckRestrictionWindows(t *testing.T) {
+	cases := []struct {
+		name         string
+		restrictions []rotationRestriction
+		err          string
+	}{
+		{
+			name: "business hours on separate days",
+			restrictions: []rotationRestriction{
+				{startDay: "monday", startTime: "09:00:00", endDay: "monday", endTime: "17:00:00"},
+				{startDay: "tuesday", startTime: "09:00:00", endDay: "tuesday", endTime: "17:00:00"},
+			},
+		},
+		{
+			name: "adjacent windows",
+			restrictions: []rotationRestriction{
+				{startDay: "monday", startTime: "09:00:00", endDay: "friday", endTime: "17:00:00"},
+				{startDay: "friday", startTime: "17:00:00", endDay: "monday", endTime: "09:00:00"},
+			},
+		},
+		{
+			name: "zero length",
+			restrictions: []rotationRestriction{
+				{startDay: "monday", startTime: "09:00:00", endDay: "monday", endTime: "09:00:00"},
+			},
+			err: "restrictions.0 starts and ends at the same time",
+		},
+		{
+			name: "zero length in another case",
+			restrictions: []rotationRestriction{
+				{startDay: "Monday", startTime: "09:00:00", endDay: "monday", endTime: "09:00:00"},
+			},
+			err: "restrictions.0 starts and ends at the same time",
+		},
+		{
+			name: "overlapping",
+			restrictions: []rotationRestriction{
+				{startDay: "monday", startTime: "09:00:00", endDay: "monday", endTime: "17:00:00"},
+				{startDay: "monday", startTime: "12:00:00", endDay: "tuesday", endTime: "12:00:00"},
+			},
+			err: "restrictions.0 overlaps restrictions.1",
+		},
+		{
+			name: "overlapping across the end of the week",
+			restrictions: []rotationRestriction{
+				{startDay: "sunday", startTime: "08:00:00", endDay: "sunday", endTime: "12:00:00"},
+				{startDay: "friday", startTime: "17:00:00", endDay: "sunday", endTime: "09:00:00"},
+			},
+			err: "restrictions.0 overlaps restrictions.1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkRestrictionWindows(c.restrictions)
+			if c.err == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if c.err != "" && (err == nil || err.Error() != c.err) {
+				t.Fatalf("expected error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCheckRotationStrategy(t *testing.T) {
+	cases := []struct {
+		name                                                            string
+		strategyType, handoffTime, handoffDay, shiftDuration, startTime string
+		err                                                             string
+	}{
+		{name: "daily", strategyType: "daily", handoffTime: "09:00:00"},
+		{name: "weekly", strategyType: "weekly", handoffTime: "09:00:00", handoffDay: "monday"},
+		{name: "custom", strategyType: "custom", shiftDuration: "PT12H", startTime: "2024-01-01T09:00:00Z"},
+		{name: "daily without handoff_time", strategyType: "daily", err: "strategy.handoff_time is required when strategy type is 'daily'"},
+		{name: "weekly without handoff_day", strategyType: "weekly", handoffTime: "09:00:00", err: "strategy.handoff_day is required when strategy type is 'weekly'"},
+		{name: "custom without shift_duration", strategyType: "custom", startTime: "2024-01-01T09:00:00Z", err: "strategy.shift_duration is required when strategy type is 'custom'"},
+		{name: "custom without start_time", strategyType: "custom", shiftDuration: "PT12H", err: "start_time is required when strategy type is 'custom'"},
+		{name: "capitalized weekly without handoff_day", strategyType: "Weekly", handoffTime: "09:00:00", err: "strategy.handoff_day is required when strategy type is 'weekly'"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkRotationStrategy(c.strategyType, c.handoffTime, c.handoffDay, c.shiftDuration, c.startTime)
+			if c.err == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if c.err != "" && (err == nil || err.Error() != c.err) {
+				t.Fatalf("expected error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestOfflineRotationStrategyValidation(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "unknown strategy type",
+			config: `
+  strategy {
+    type = "monthly"
+  }`,
+			err: `expected strategy.0.type to be one of|value must be one of`,
+		},
+		{
+			name: "handoff_time without seconds",
+			config: `
+  strategy {
+    type         = "daily"
+    handoff_time = "9:00"
+  }`,
+			err: `HH:MM:SS`,
+		},
+		{
+			name: "unknown handoff_day",
+			config: `
+  strategy {
+    type         = "weekly"
+    handoff_time = "09:00:00"
+    handoff_day  = "Mondays"
+  }`,
+			err: `expected a day of the week`,
+		},
+		{
+			name: "invalid shift_duration",
+			config: `
+  start_time = "2024-01-01T09:00:00Z"
+  strategy {
+    type           = "custom"
+    shift_duration = "12 hours"
+  }`,
+			err: `ISO8601 duration`,
+		},
+		{
+			name: "weekly without handoff_day",
+			config: `
+  strategy {
+    type         = "weekly"
+    handoff_time = "09:00:00"
+  }`,
+			err: `strategy.handoff_day is required when strategy type is 'weekly'`,
+		},
+		{
+			name: "overlapping restrictions",
+			config: `
+  strategy {
+    type         = "daily"
+    handoff_time = "09:00:00"
+  }
+  restrictions {
+    start_day  = "monday"
+    start_time = "09:00:00"
+    end_day    = "friday"
+    end_time   = "17:00:00"
+  }
+  restrictions {
+    start_day  = "friday"
+    start_time = "12:00:00"
+    end_day    = "saturday"
+    end_time   = "12:00:00"
+  }`,
+			err: `restrictions.0 overlaps restrictions.1`,
+		},
+		{
+			name: "zero length restriction",
+			config: `
+  strategy {
+    type         = "daily"
+    handoff_time = "09:00:00"
+  }
+  restrictions {
+    start_day  = "monday"
+    start_time = "09:00:00"
+    end_day    = "monday"
+    end_time   = "09:00:00"
+  }`,
+			err: `restrictions.0 starts and ends at the same time`,
+		},
+	}
+
+	for _, resourceType := range []string{"firehydrant_rotation", "firehydrant_on_call_schedule"} {
+		t.Run(resourceType, func(t *testing.T) {
+			steps := []resource.TestStep{{
+				Config:      testFakeAPIProviderConfig(server) + testRotationValidationConfig(resourceType, `time_zone = "America/Nowhere"`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`IANA time zone`),
+			}}
+			for _, c := range cases {
+				steps = append(steps, resource.TestStep{
+					Config:      testFakeAPIProviderConfig(server) + testRotationValidationConfig(resourceType, `time_zone = "America/New_York"`, c.config),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(c.err),
+				})
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: defaultProviderFactories(),
+				Steps:                    steps,
+			})
+		})
+	}
+}
+
+// Strategy types and days are case-insensitive in the API, so configurations in another case
+// are sent the way the API returns them and show no diff against it
+func TestOfflineRotationCaseInsensitive(t *testing.T) {
+	capitalized := `
+  strategy {
+    type         = "Weekly"
+    handoff_time = "09:00:00"
+    handoff_day  = "Monday"
+  }
+  restrictions {
+    start_day  = "Monday"
+    start_time = "09:00:00"
+    end_day    = "FRIDAY"
+    end_time   = "17:00:00"
+  }`
+	lowercase := `
+  strategy {
+    type         = "weekly"
+    handoff_time = "09:00:00"
+    handoff_day  = "monday"
+  }
+  restrictions {
+    start_day  = "monday"
+    start_time = "09:00:00"
+    end_day    = "friday"
+    end_time   = "17:00:00"
+  }`
+
+	for resourceType, collectionPath := range map[string]string{
+		"firehydrant_rotation":         "/v1/teams/{team_id}/on_call_schedules/schedule-1/rotations",
+		"firehydrant_on_call_schedule": "/v1/teams/{team_id}/on_call_schedules",
+	} {
+		t.Run(resourceType, func(t *testing.T) {
+			server := fakeapi.NewServer()
+			defer server.Close()
+
+			testFakeAPICase(t, server, resourceType, collectionPath, []resource.TestStep{
+				{
+					Config: testRotationValidationConfig(resourceType, `time_zone = "America/New_York"`, capitalized),
+					Check: func(s *terraform.State) error {
+						rs := s.RootModule().Resources[resourceType+".test"]
+						obj, ok := server.Get(strings.ReplaceAll(collectionPath, "{team_id}", "team-1") + "/" + rs.Primary.ID)
+						if !ok {
+							return fmt.Errorf("%s %s not found", resourceType, rs.Primary.ID)
+						}
+						strategy, _ := obj["strategy"].(map[string]interface{})
+						restrictions, _ := obj["restrictions"].([]interface{})
+						restriction, _ := restrictions[0].(map[string]interface{})
+						for name, value := range map[string]interface{}{
+							"type": strategy["type"], "handoff_day": strategy["handoff_day"],
+							"start_day": restriction["start_day"], "end_day": restriction["end_day"],
+						} {
+							if value != strings.ToLower(fmt.Sprint(value)) {
+								return fmt.Errorf("expected %s to be sent in lowercase, got %v", name, value)
+							}
+						}
+						return nil
+					},
+				},
+				{
+					Config:   testRotationValidationConfig(resourceType, `time_zone = "America/New_York"`, lowercase),
+					PlanOnly: true,
+				},
+			})
+		})
+	}
+}
+
+func testRotationValidationConfig(resourceType, timeZone, body string) string {
+	scheduleID := ""
+	if resourceType == "firehydrant_rotation" {
+		scheduleID = `schedule_id = "schedule-1"`
+	}
+	if body == "" {
+		body = `
+  strategy {
+    type         = "daily"
+    handoff_time = "09:00:00"
+  }`
+	}
+	return fmt.Sprintf(`
+resource "%s" "test" {
+  team_id = "team-1"
+  %s
+  name    = "test"
+  %s
+%s
+}
+`, resourceType, scheduleID, timeZone, body)
+}
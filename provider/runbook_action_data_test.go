@@ -9,8 +9,8 @@ import (
 func TestAccRunbookActionDataSource_basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookActionDataSourceConfig_basic(),
@@ -30,8 +30,8 @@ func TestAccRunbookActionDataSource_basic(t *testing.T) {
 func TestAccRunbookActionDataSource_allAttributes(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookActionDataSourceConfig_allAttributes(),
@@ -53,8 +53,8 @@ func TestAccRunbookActionDataSource_allAttributes(t *testing.T) {
 func TestAccRunbookActionDataSource_multipleActionsForSlug(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookActionDataSourceConfig_multipleActionsForSlug(),
@@ -13,15 +13,15 @@ func TestAccRunbookDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rName)),
+						"data.firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rName)),
 				),
 			},
 		},
@@ -33,15 +33,15 @@ func TestAccRunbookDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rName)),
+						"data.firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_runbook.test_runbook", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttrSet("data.firehydrant_runbook.test_runbook", "owner_id"),
@@ -60,7 +60,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
 
   steps {
     name      = "Create Incident Channel"
@@ -80,7 +80,7 @@ data "firehydrant_runbook" "test_runbook" {
 func testAccRunbookDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team1" {
-  name = "test-team1-%s"
+  name = "tf-acc-team1-%s"
 }
 
 data "firehydrant_runbook_action" "create_incident_channel" {
@@ -89,7 +89,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name        = "test-runbook-%s"
+  name        = "tf-acc-runbook-%s"
   description = "test-description-%s"
   owner_id    = firehydrant_team.test_team1.id
 	attachment_rule = jsonencode({
@@ -3,11 +3,10 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,8 +21,9 @@ func resourceRunbook() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantRunbook,
 		ReadContext:   readResourceFireHydrantRunbook,
 		DeleteContext: deleteResourceFireHydrantRunbook,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: runbookImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -135,7 +135,7 @@ func readResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceData,
 	})
 	runbookResponse, err := firehydrantAPIClient.Runbooks().Get(ctx, runbookID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Runbook %s no longer exists", runbookID), map[string]interface{}{
 				"id": runbookID,
 			})
@@ -382,7 +382,7 @@ func deleteResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceDat
 	})
 	err := firehydrantAPIClient.Runbooks().Delete(ctx, runbookID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting runbook %s: %v", runbookID, err)
@@ -390,3 +390,24 @@ func deleteResourceFireHydrantRunbook(ctx context.Context, d *schema.ResourceDat
 
 	return diag.Diagnostics{}
 }
+
+var runbookImportLookup = importLookup{
+	resourceName: "runbook",
+	fields:       []string{"name"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := firehydrant.RunbookQuery{Name: value, PerPage: importLookupPerPage}
+		return listImportCandidates(ctx, client, &request,
+			func(request *firehydrant.RunbookQuery, page *int) { request.Page = *page },
+			func(ctx context.Context, request *firehydrant.RunbookQuery) (pagination.PaginateResponse[firehydrant.RunbookResponse], error) {
+				response, err := client.Runbooks().List(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				return pagination.RESTPage[firehydrant.RunbookResponse]{Data: response.Runbooks, Pagination: response.Pagination}, nil
+			},
+			func(runbook firehydrant.RunbookResponse) importCandidate {
+				return importCandidate{ID: runbook.ID, Name: runbook.Name}
+			},
+		)
+	},
+}
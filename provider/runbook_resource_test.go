@@ -19,9 +19,9 @@ func TestAccRunbookResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRunbookResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRunbookResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookResourceConfig_basic(rName),
@@ -29,7 +29,7 @@ func TestAccRunbookResource_basic(t *testing.T) {
 					testAccCheckRunbookResourceExistsWithAttributes_basic("firehydrant_runbook.test_runbook"),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rName)),
+						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rName)),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "attachment_rule"),
 					resource.TestCheckResourceAttr(
 						"firehydrant_runbook.test_runbook", "steps.#", "1"),
@@ -54,9 +54,9 @@ func TestAccRunbookResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckRunbookResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckRunbookResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookResourceConfig_basic(rName),
@@ -64,7 +64,7 @@ func TestAccRunbookResource_update(t *testing.T) {
 					testAccCheckRunbookResourceExistsWithAttributes_basic("firehydrant_runbook.test_runbook"),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rName)),
+						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rName)),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "attachment_rule"),
 					resource.TestCheckResourceAttr(
 						"firehydrant_runbook.test_runbook", "steps.#", "1"),
@@ -84,7 +84,7 @@ func TestAccRunbookResource_update(t *testing.T) {
 					testAccCheckRunbookResourceExistsWithAttributes_update("firehydrant_runbook.test_runbook"),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rNameUpdated)),
+						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_runbook.test_runbook", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "owner_id"),
@@ -110,7 +110,7 @@ func TestAccRunbookResource_update(t *testing.T) {
 					testAccCheckRunbookResourceExistsWithAttributes_basic("firehydrant_runbook.test_runbook"),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("test-runbook-%s", rNameUpdated)),
+						"firehydrant_runbook.test_runbook", "name", fmt.Sprintf("tf-acc-runbook-%s", rNameUpdated)),
 					resource.TestCheckResourceAttrSet("firehydrant_runbook.test_runbook", "attachment_rule"),
 					resource.TestCheckResourceAttr(
 						"firehydrant_runbook.test_runbook", "steps.#", "1"),
@@ -133,8 +133,8 @@ func TestAccRunbookResource_validateSchemaAttributesStepsConfig(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRunbookResourceConfig_stepsConfigInvalidJSON(rName),
@@ -149,8 +149,8 @@ func TestAccRunbookResource_validateSchemaAttributesAttachmentRule(t *testing.T)
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRunbookResourceConfig_attachmentRuleInvalidJSON(rName),
@@ -165,8 +165,8 @@ func TestAccRunbookResource_validateSchemaAttributesStepsRule(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRunbookResourceConfig_stepsRuleInvalidJSON(rName),
@@ -181,8 +181,8 @@ func TestAccRunbookResource_validateSchemaAttributesStepsRepeatsDuration(t *test
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRunbookResourceConfig_stepsRequiredRepeatsDurationNotSet(rName),
@@ -197,8 +197,8 @@ func TestAccRunbookResourceImport_validateSchemaAttributesStepsRepeats(t *testin
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRunbookResourceConfig_stepsRequiredRepeatsNotSet(rName),
@@ -213,8 +213,8 @@ func TestAccRunbookResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookResourceConfig_basic(rName),
@@ -234,8 +234,8 @@ func TestAccRunbookResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRunbookResourceConfig_update(rName, sharedTeamID),
@@ -495,7 +495,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
 
   steps {
     name      = "Create Incident Channel"
@@ -521,7 +521,7 @@ data "firehydrant_runbook_action" "archive_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name        = "test-runbook-%s"
+  name        = "tf-acc-runbook-%s"
   description = "test-description-%s"
   owner_id    = "%s"
   attachment_rule = jsonencode({
@@ -591,7 +591,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
   attachment_rule = jsonencode({
     logic = {
       eq = [
@@ -651,7 +651,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
   attachment_rule = jsonencode({
     logic = {
       eq = [
@@ -711,7 +711,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
 
   steps {
     name      = "Create Incident Channel"
@@ -730,7 +730,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name            = "test-runbook-%s"
+  name            = "tf-acc-runbook-%s"
   attachment_rule = "{invalid_json = {{}}"
 
   steps {
@@ -748,7 +748,7 @@ data "firehydrant_runbook_action" "create_incident_channel" {
 }
 
 resource "firehydrant_runbook" "test_runbook" {
-  name = "test-runbook-%s"
+  name = "tf-acc-runbook-%s"
 
   steps {
     name      = "Create Incident Channel"
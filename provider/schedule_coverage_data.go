@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceScheduleCoverage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantScheduleCoverage,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"rotation": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The rotations of the schedule, with the same arguments as firehydrant_rotation",
+				Elem: &schema.Resource{
+					Schema: rotationPreviewSchema(),
+				},
+			},
+
+			// Optional
+			"window_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "RFC3339 timestamp of the start of the checked window. Defaults to now.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"weeks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				Description:  "The number of weeks from window_start to check",
+				ValidateFunc: validation.IntBetween(1, 52),
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				Description:  "The time zone the gaps are reported in",
+				ValidateFunc: validateWith(checkTimeZone),
+			},
+
+			// Computed
+			"gaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The windows in which none of the rotations has a member on call, in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataFireHydrantScheduleCoverage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	location, _ := time.LoadLocation(d.Get("time_zone").(string))
+	windowStart := time.Now().Truncate(time.Minute)
+	if v, ok := d.GetOk("window_start"); ok {
+		windowStart, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	windowEnd := windowStart.AddDate(0, 0, 7*d.Get("weeks").(int))
+
+	var previews []rotationPreview
+	for i, r := range d.Get("rotation").([]interface{}) {
+		preview, err := rotationPreviewFromMap(r.(map[string]interface{}), windowStart)
+		if err != nil {
+			return diag.Errorf("Error in rotation %d: %v", i, err)
+		}
+		previews = append(previews, preview)
+	}
+
+	gaps, err := scheduleCoverageGaps(previews, windowStart, windowEnd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gapMaps := make([]map[string]interface{}, 0, len(gaps))
+	for _, gap := range gaps {
+		gapMaps = append(gapMaps, map[string]interface{}{
+			"start_time": gap.start.In(location).Format(time.RFC3339),
+			"end_time":   gap.end.In(location).Format(time.RFC3339),
+		})
+	}
+	if err := d.Set("gaps", gapMaps); err != nil {
+		return diag.Errorf("Error setting gaps: %v", err)
+	}
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diag.Diagnostics{}
+}
+
+// scheduleCoverageGaps returns the parts of [from, to) that no rotation has an assigned shift
+// in. Shifts of unassigned member slots and times outside a rotation's restrictions don't
+// count as covered.
+func scheduleCoverageGaps(previews []rotationPreview, from, to time.Time) ([]timeRange, error) {
+	var covered []timeRange
+	for i, preview := range previews {
+		shifts, err := preview.shifts(from, to)
+		if err != nil {
+			return nil, fmt.Errorf("error in rotation %d: %w", i, err)
+		}
+		for _, shift := range shifts {
+			if shift.userID != "" {
+				covered = append(covered, timeRange{start: shift.start, end: shift.end})
+			}
+		}
+	}
+
+	var gaps []timeRange
+	start := from
+	for _, c := range mergeRanges(covered) {
+		if c.start.After(start) {
+			gaps = append(gaps, timeRange{start: start, end: c.start})
+		}
+		start = laterOf(start, c.end)
+	}
+	if to.After(start) {
+		gaps = append(gaps, timeRange{start: start, end: to})
+	}
+	return gaps, nil
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestScheduleCoverageGaps(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	businessHours := rotationPreview{
+		location:     newYork,
+		startTime:    parse("2024-06-03T09:00:00-04:00"),
+		strategyType: "daily",
+		handoffTime:  "09:00:00",
+		members:      []string{"alice"},
+		restrictions: []rotationRestriction{
+			{startDay: "monday", startTime: "09:00:00", endDay: "friday", endTime: "17:00:00"},
+		},
+	}
+	afterHours := rotationPreview{
+		location:     newYork,
+		startTime:    parse("2024-06-03T09:00:00-04:00"),
+		strategyType: "daily",
+		handoffTime:  "17:00:00",
+		members:      []string{"bob"},
+		restrictions: []rotationRestriction{
+			{startDay: "monday", startTime: "17:00:00", endDay: "friday", endTime: "09:00:00"},
+			{startDay: "friday", startTime: "17:00:00", endDay: "sunday", endTime: "17:00:00"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		previews []rotationPreview
+		from, to string
+		gaps     []string
+	}{
+		{
+			name:     "business hours only",
+			previews: []rotationPreview{businessHours},
+			from:     "2024-06-07T00:00:00-04:00",
+			to:       "2024-06-10T12:00:00-04:00",
+			gaps: []string{
+				"2024-06-07T17:00:00-04:00 2024-06-10T09:00:00-04:00",
+			},
+		},
+		{
+			name:     "nobody on call sunday nights",
+			previews: []rotationPreview{businessHours, afterHours},
+			from:     "2024-06-03T09:00:00-04:00",
+			to:       "2024-06-17T09:00:00-04:00",
+			gaps: []string{
+				"2024-06-09T17:00:00-04:00 2024-06-10T09:00:00-04:00",
+				"2024-06-16T17:00:00-04:00 2024-06-17T09:00:00-04:00",
+			},
+		},
+		{
+			name: "unassigned slot",
+			previews: []rotationPreview{{
+				location:     newYork,
+				startTime:    parse("2024-06-06T10:00:00-04:00"),
+				strategyType: "weekly",
+				handoffTime:  "10:00:00",
+				handoffDay:   "thursday",
+				members:      []string{"alice", "", "bob"},
+			}},
+			from: "2024-06-06T10:00:00-04:00",
+			to:   "2024-06-27T10:00:00-04:00",
+			gaps: []string{
+				"2024-06-13T10:00:00-04:00 2024-06-20T10:00:00-04:00",
+			},
+		},
+		{
+			name: "overlapping rotations",
+			previews: []rotationPreview{
+				{location: newYork, startTime: parse("2024-06-03T00:00:00-04:00"), strategyType: "custom", shiftDuration: "PT12H", members: []string{"alice", "bob"}},
+				{location: newYork, startTime: parse("2024-06-03T06:00:00-04:00"), strategyType: "custom", shiftDuration: "PT12H", members: []string{"", "carol"}},
+			},
+			from: "2024-06-03T00:00:00-04:00",
+			to:   "2024-06-04T00:00:00-04:00",
+			gaps: []string{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gaps, err := scheduleCoverageGaps(c.previews, parse(c.from), parse(c.to))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, 0, len(gaps))
+			for _, gap := range gaps {
+				got = append(got, fmt.Sprintf("%s %s", gap.start.In(newYork).Format(time.RFC3339), gap.end.In(newYork).Format(time.RFC3339)))
+			}
+			if strings.Join(got, "\n") != strings.Join(c.gaps, "\n") {
+				t.Fatalf("expected gaps\n%s\ngot\n%s", strings.Join(c.gaps, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestOfflineScheduleCoverage(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + `
+data "firehydrant_schedule_coverage" "test" {
+  time_zone = "Mars/Olympus_Mons"
+
+  rotation {
+    time_zone = "UTC"
+
+    strategy {
+      type         = "daily"
+      handoff_time = "09:00:00"
+    }
+
+    members {
+      user_id = "alice"
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected an IANA time zone`),
+			},
+			{
+				Config: testFakeAPIProviderConfig(server) + `
+data "firehydrant_schedule_coverage" "test" {
+  window_start = "2024-06-03T09:00:00-04:00"
+  weeks        = 1
+  time_zone    = "America/New_York"
+
+  rotation {
+    time_zone = "America/New_York"
+
+    strategy {
+      type         = "weekly"
+      handoff_time = "09:00:00"
+      handoff_day  = "monday"
+    }
+
+    members {
+      user_id = "alice"
+    }
+
+    restrictions {
+      start_day  = "monday"
+      start_time = "09:00:00"
+      end_day    = "friday"
+      end_time   = "17:00:00"
+    }
+  }
+
+  rotation {
+    time_zone = "Europe/London"
+
+    strategy {
+      type           = "custom"
+      shift_duration = "P1D"
+    }
+
+    members {
+      user_id = "bob"
+    }
+
+    restrictions {
+      start_day  = "friday"
+      start_time = "22:00:00"
+      end_day    = "monday"
+      end_time   = "13:00:00"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firehydrant_schedule_coverage.test", "gaps.#", "1"),
+					resource.TestCheckResourceAttr("data.firehydrant_schedule_coverage.test", "gaps.0.start_time", "2024-06-10T08:00:00-04:00"),
+					resource.TestCheckResourceAttr("data.firehydrant_schedule_coverage.test", "gaps.0.end_time", "2024-06-10T09:00:00-04:00"),
+				),
+			},
+		},
+	})
+}
@@ -48,8 +48,8 @@ func TestScheduleDataSource_OneMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testScheduleDataSourceConfig_basic(),
@@ -87,8 +87,8 @@ func TestScheduleDataSource_MultipleMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testScheduleDataSourceConfig_exactMatch(),
@@ -126,8 +126,8 @@ func TestScheduleDataSource_MultipleMatchesNoExactMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testScheduleDataSourceConfig_exactMatch(),
@@ -159,8 +159,8 @@ func TestScheduleDataSource_NoMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testScheduleDataSourceConfig_basic(),
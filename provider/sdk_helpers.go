@@ -73,3 +73,11 @@ func unmarshalLabels(labelsMap interface{}) (map[string]string, error) {
 		return stringMap, nil
 	}
 }
+
+// stringValue dereferences an optional string returned by the SDK, treating nil as empty
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// Server serves the provider over version 5 of the plugin protocol. Resources are moving
+// from the plugin SDK to the plugin framework one at a time, and it serves both halves of
+// the provider as one, sending each request to the half that implements the resource.
+type Server struct {
+	tfprotov5.ProviderServer
+
+	client *configuredClient
+}
+
+// NewServer returns the provider's server
+func NewServer(ctx context.Context) (*Server, error) {
+	return newServer(ctx, &configuredClient{})
+}
+
+func newServer(ctx context.Context, client *configuredClient) (*Server, error) {
+	// The plugin SDK provider comes first: it validates the provider configuration and is
+	// configured first, so the plugin framework provider only reuses its client.
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		newProvider(client).GRPCProvider,
+		providerserver.NewProtocol5(newFrameworkProvider(client)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{ProviderServer: mux.ProviderServer(), client: client}, nil
+}
+
+// Client returns the API client the provider was configured with, nil until it was
+func (s *Server) Client() *firehydrant.APIClient {
+	return s.client.current()
+}
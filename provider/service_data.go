@@ -6,7 +6,6 @@ import (
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,6 +82,8 @@ func dataFireHydrantService(ctx context.Context, d *schema.ResourceData, m inter
 	// Get the API client
 	client := m.(*firehydrant.APIClient)
 
+	ctx = firehydrant.WithCachedReads(ctx)
+
 	// Get the service
 	serviceID := d.Get("id").(string)
 	tflog.Debug(ctx, fmt.Sprintf("Read service: %s", serviceID), map[string]interface{}{
@@ -90,7 +91,7 @@ func dataFireHydrantService(ctx context.Context, d *schema.ResourceData, m inter
 	})
 	serviceResponse, err := client.Sdk.CatalogEntries.GetService(ctx, serviceID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return diag.Errorf("Service %s not found", serviceID)
 		}
 		return diag.Errorf("Error reading service %s: %v", serviceID, err)
@@ -13,15 +13,15 @@ func TestAccServiceDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"data.firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -39,15 +39,15 @@ func TestAccServiceDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"data.firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -59,7 +59,7 @@ func TestAccServiceDataSource_allAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_service.test_service", "links.#", "2"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_service.test_service", "links.0.name", fmt.Sprintf("test-link1-%s", rName)),
+						"data.firehydrant_service.test_service", "links.0.name", fmt.Sprintf("tf-acc-link1-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_service.test_service", "links.0.href_url", fmt.Sprintf("https://example.com/test-link1-%s", rName)),
 					resource.TestCheckResourceAttrSet("data.firehydrant_service.test_service", "owner_id"),
@@ -75,7 +75,7 @@ func TestAccServiceDataSource_allAttributes(t *testing.T) {
 func testAccServiceDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name = "test-service-%s"
+  name = "tf-acc-service-%s"
 }
 
 data "firehydrant_service" "test_service" {
@@ -86,19 +86,19 @@ data "firehydrant_service" "test_service" {
 func testAccServiceDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team1" {
-  name = "test-team1-%s"
+  name = "tf-acc-team1-%s"
 }
 
 resource "firehydrant_team" "test_team2" {
-  name = "test-team2-%s"
+  name = "tf-acc-team2-%s"
 }
 
 resource "firehydrant_team" "test_team3" {
-  name = "test-team3-%s"
+  name = "tf-acc-team3-%s"
 }
 
 resource "firehydrant_service" "test_service" {
-  name                     = "test-service-%s"
+  name                     = "tf-acc-service-%s"
   alert_on_add             = true
   auto_add_responding_team = true
   description              = "test-description-%s"
@@ -109,11 +109,11 @@ resource "firehydrant_service" "test_service" {
 
   links {
     href_url = "https://example.com/test-link1-%s"
-    name = "test-link1-%s"
+    name = "tf-acc-link1-%s"
   }
   links {
     href_url = "https://example.com/test-link2-%s"
-    name = "test-link2-%s"
+    name = "tf-acc-link2-%s"
   }
 
   owner_id     = firehydrant_team.test_team1.id
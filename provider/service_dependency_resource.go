@@ -2,8 +2,8 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
@@ -18,8 +18,9 @@ func resourceServiceDependency() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantServiceDependency,
 		ReadContext:   readResourceFireHydrantServiceDependency,
 		DeleteContext: deleteResourceFireHydrantServiceDependency,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importResourceFireHydrantServiceDependency,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -54,7 +55,7 @@ func readResourceFireHydrantServiceDependency(ctx context.Context, d *schema.Res
 	})
 	serviceDependencyResponse, err := firehydrantAPIClient.ServiceDependencies().Get(ctx, serviceDependencyID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Service dependency %s no longer exists", serviceDependencyID), map[string]interface{}{
 				"id": serviceDependencyID,
 			})
@@ -142,7 +143,7 @@ func deleteResourceFireHydrantServiceDependency(ctx context.Context, d *schema.R
 	})
 	err := firehydrantAPIClient.ServiceDependencies().Delete(ctx, serviceDependencyID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting service dependency %s: %v", serviceDependencyID, err)
@@ -150,3 +151,56 @@ func deleteResourceFireHydrantServiceDependency(ctx context.Context, d *schema.R
 
 	return diag.Diagnostics{}
 }
+
+// importResourceFireHydrantServiceDependency imports a service dependency by its ID, or by
+// <Service_ID>:name=<name> or <Service_ID>:slug=<slug> of the service on the other end of the
+// dependency. The service can itself be given by name=<name> or slug=<slug>.
+func importResourceFireHydrantServiceDependency(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	serviceID, connectedService, ok := strings.Cut(d.Id(), ":")
+	if !ok {
+		return []*schema.ResourceData{d}, nil
+	}
+	if _, _, isLookup := parseImportLookup(connectedService); serviceID == "" || !isLookup {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected Service_Dependency_ID, Service_ID:name=<name> or Service_ID:slug=<slug>", d.Id())
+	}
+
+	client := m.(*firehydrant.APIClient)
+	serviceID, err := serviceImportLookup.resolve(ctx, client, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := serviceDependencyImportLookup(serviceID).resolve(ctx, client, connectedService)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// serviceDependencyImportLookup resolves the dependencies of the given service by the name or
+// slug of the service on the other end, in either direction
+func serviceDependencyImportLookup(serviceID string) importLookup {
+	return importLookup{
+		resourceName: "service dependency",
+		fields:       []string{"name", "slug"},
+		list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+			dependencies, err := client.Sdk.CatalogEntries.GetServiceDependencies(ctx, serviceID, nil)
+			if err != nil {
+				return nil, err
+			}
+			var candidates []importCandidate
+			for _, dependency := range dependencies.GetChildServiceDependencies() {
+				if service := dependency.GetService(); service != nil {
+					candidates = append(candidates, importCandidate{ID: stringValue(dependency.GetID()), Name: stringValue(service.GetName()), Slug: stringValue(service.GetSlug())})
+				}
+			}
+			for _, dependency := range dependencies.GetParentServiceDependencies() {
+				if service := dependency.GetService(); service != nil {
+					candidates = append(candidates, importCandidate{ID: stringValue(dependency.GetID()), Name: stringValue(service.GetName()), Slug: stringValue(service.GetSlug())})
+				}
+			}
+			return candidates, nil
+		},
+	}
+}
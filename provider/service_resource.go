@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +20,10 @@ func resourceService() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantService,
 		ReadContext:   readResourceFireHydrantService,
 		DeleteContext: deleteResourceFireHydrantService,
+		CustomizeDiff: customizeDiffLabels,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: serviceImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -48,6 +51,7 @@ func resourceService() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"labels_all": labelsAllSchema(),
 			"links": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -113,7 +117,7 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 	})
 	serviceResponse, err := client.Sdk.CatalogEntries.GetService(ctx, serviceID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Service %s no longer exists", serviceID), map[string]interface{}{
 				"id": serviceID,
 			})
@@ -156,7 +160,6 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 		"alert_on_add":             alertOnAdd,
 		"auto_add_responding_team": autoAddRespondingTeam,
 		"description":              description,
-		"labels":                   labelsMap,
 		"service_tier":             serviceTier,
 	}
 
@@ -203,6 +206,10 @@ func readResourceFireHydrantService(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if err := setLabels(client, d, labelsMap); err != nil {
+		return diag.Errorf("Error setting labels for service %s: %v", serviceID, err)
+	}
+
 	return diag.Diagnostics{}
 }
 
@@ -216,7 +223,7 @@ func createResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 	alertOnAdd := d.Get("alert_on_add").(bool)
 	autoAddRespondingTeam := d.Get("auto_add_responding_team").(bool)
 	serviceTier := d.Get("service_tier").(int)
-	labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+	labels := mergedLabels(client, d.Get("labels").(map[string]interface{}))
 
 	createRequest := components.CreateService{
 		Name:                  name,
@@ -292,7 +299,7 @@ func updateResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 	alertOnAdd := d.Get("alert_on_add").(bool)
 	autoAddRespondingTeam := d.Get("auto_add_responding_team").(bool)
 	serviceTier := d.Get("service_tier").(int)
-	labels := convertStringMap(d.Get("labels").(map[string]interface{}))
+	labels := mergedLabels(client, d.Get("labels").(map[string]interface{}))
 
 	// Process any optional attributes and add to the update request if necessary
 
@@ -383,7 +390,7 @@ func deleteResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 	})
 	err := client.Sdk.CatalogEntries.DeleteService(ctx, serviceID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting service %s: %v", serviceID, err)
@@ -391,3 +398,23 @@ func deleteResourceFireHydrantService(ctx context.Context, d *schema.ResourceDat
 
 	return diag.Diagnostics{}
 }
+
+var serviceImportLookup = importLookup{
+	resourceName: "service",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListServicesRequest{PerPage: ptr.Of(importLookupPerPage)}
+		if field == "name" {
+			request.Name = &value
+		}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListServicesRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListServicesRequest) (pagination.PaginateResponse[components.ServiceEntity], error) {
+				return client.Sdk.CatalogEntries.ListServices(ctx, *request)
+			},
+			func(service components.ServiceEntity) importCandidate {
+				return importCandidate{ID: stringValue(service.GetID()), Name: stringValue(service.GetName()), Slug: stringValue(service.GetSlug())}
+			},
+		)
+	},
+}
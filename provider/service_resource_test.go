@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -15,9 +16,9 @@ func TestAccServiceResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckServiceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckServiceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_basic(rName),
@@ -25,7 +26,7 @@ func TestAccServiceResource_basic(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_basic("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -47,9 +48,9 @@ func TestAccServiceResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckServiceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckServiceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_basic(rName),
@@ -57,7 +58,7 @@ func TestAccServiceResource_update(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_basic("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -73,7 +74,7 @@ func TestAccServiceResource_update(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -96,7 +97,7 @@ func TestAccServiceResource_update(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_basic("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -118,9 +119,9 @@ func TestAccServiceResource_updateLabels(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckServiceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckServiceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_update(rName, sharedTeamID, sharedTeamID2),
@@ -128,7 +129,7 @@ func TestAccServiceResource_updateLabels(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -151,7 +152,7 @@ func TestAccServiceResource_updateLabels(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -176,7 +177,7 @@ func TestAccServiceResource_updateLabels(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_basic("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -203,9 +204,9 @@ func TestAccServiceResource_updateOwnerIDAndTeamIDs(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckServiceResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckServiceResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_update(rName, sharedTeamID, sharedTeamID2),
@@ -213,7 +214,7 @@ func TestAccServiceResource_updateOwnerIDAndTeamIDs(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rName)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -236,7 +237,7 @@ func TestAccServiceResource_updateOwnerIDAndTeamIDs(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -259,7 +260,7 @@ func TestAccServiceResource_updateOwnerIDAndTeamIDs(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_update("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "true"),
 					resource.TestCheckResourceAttr(
@@ -282,7 +283,7 @@ func TestAccServiceResource_updateOwnerIDAndTeamIDs(t *testing.T) {
 					testAccCheckServiceResourceExistsWithAttributes_basic("firehydrant_service.test_service"),
 					resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_service.test_service", "name", fmt.Sprintf("test-service-%s", rNameUpdated)),
+						"firehydrant_service.test_service", "name", fmt.Sprintf("tf-acc-service-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_service.test_service", "alert_on_add", "false"),
 					resource.TestCheckResourceAttr(
@@ -304,8 +305,8 @@ func TestAccServiceResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_basic(rName),
@@ -326,8 +327,8 @@ func TestAccServiceResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServiceResourceConfig_update(rName, sharedTeamID, sharedTeamID2),
@@ -341,6 +342,46 @@ func TestAccServiceResourceImport_allAttributes(t *testing.T) {
 	})
 }
 
+func TestOfflineServiceResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teams := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"}, map[string]interface{}{"name": "Checkout"})
+	teamID, teamID2 := teams[0]["id"].(string), teams[1]["id"].(string)
+
+	testFakeAPICase(t, server, "firehydrant_service", "/v1/services", []resource.TestStep{
+		{
+			Config: testAccServiceResourceConfig_basic("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_service.test_service", "id"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "name", "tf-acc-service-offline"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "service_tier", "5"),
+			),
+		},
+		{
+			Config: testAccServiceResourceConfig_update("offline", teamID, teamID2),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "description", "test-description-offline"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "labels.test1", "test-label1-offline"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "links.#", "2"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "owner_id", teamID),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "service_tier", "1"),
+				resource.TestCheckResourceAttr("firehydrant_service.test_service", "team_ids.#", "2"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_service.test_service",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		{
+			ResourceName:      "firehydrant_service.test_service",
+			ImportStateId:     "name=tf-acc-service-offline",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccCheckServiceResourceExistsWithAttributes_basic(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		serviceResource, ok := s.RootModule().Resources[resourceName]
@@ -530,14 +571,14 @@ func testAccCheckServiceResourceDestroy() resource.TestCheckFunc {
 func testAccServiceResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name = "test-service-%s"
+  name = "tf-acc-service-%s"
 }`, rName)
 }
 
 func testAccServiceResourceConfig_update(rName, sharedTeamID, sharedTeamID2 string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name                     = "test-service-%s"
+  name                     = "tf-acc-service-%s"
   alert_on_add             = true
   auto_add_responding_team = true
   description              = "test-description-%s"
@@ -546,11 +587,11 @@ resource "firehydrant_service" "test_service" {
   }
   links {
     href_url = "https://example.com/test-link1-%s"
-    name = "test-link1-%s"
+    name = "tf-acc-link1-%s"
   }
   links {
     href_url = "https://example.com/test-link2-%s"
-    name = "test-link2-%s"
+    name = "tf-acc-link2-%s"
   }
   owner_id     = "%s"
   service_tier = "1"
@@ -564,7 +605,7 @@ resource "firehydrant_service" "test_service" {
 func testAccServiceResourceConfig_updateChangeLabels(rName, sharedTeamID, sharedTeamID2 string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name                     = "test-service-%s"
+  name                     = "tf-acc-service-%s"
   alert_on_add             = true
   auto_add_responding_team = true
   description              = "test-description-%s"
@@ -574,11 +615,11 @@ resource "firehydrant_service" "test_service" {
   }
   links {
     href_url = "https://example.com/test-link1-%s"
-    name = "test-link1-%s"
+    name = "tf-acc-link1-%s"
   }
   links {
     href_url = "https://example.com/test-link2-%s"
-    name = "test-link2-%s"
+    name = "tf-acc-link2-%s"
   }
   owner_id     = "%s"
   service_tier = "1"
@@ -592,7 +633,7 @@ resource "firehydrant_service" "test_service" {
 func testAccServiceResourceConfig_updateChangeOwnerIDAndTeamIDs(rName, sharedTeamID, sharedTeamID2 string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_service" "test_service" {
-  name                     = "test-service-%s"
+  name                     = "tf-acc-service-%s"
   alert_on_add             = true
   auto_add_responding_team = true
   description              = "test-description-%s"
@@ -601,11 +642,11 @@ resource "firehydrant_service" "test_service" {
   }
   links {
     href_url = "https://example.com/test-link1-%s"
-    name = "test-link1-%s"
+    name = "tf-acc-link1-%s"
   }
   links {
     href_url = "https://example.com/test-link2-%s"
-    name = "test-link2-%s"
+    name = "tf-acc-link2-%s"
   }
   owner_id     = "%s"
   service_tier = "1"
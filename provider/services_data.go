@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
 	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,6 +46,8 @@ func dataFireHydrantServices(ctx context.Context, d *schema.ResourceData, m inte
 	// Get the API client
 	client := m.(*firehydrant.APIClient)
 
+	ctx = firehydrant.WithCachedReads(ctx)
+
 	// Build the list services request
 	query := d.Get("query").(string)
 	labels := d.Get("labels").(map[string]interface{})
@@ -62,7 +67,9 @@ func dataFireHydrantServices(ctx context.Context, d *schema.ResourceData, m inte
 		"labels": labelsStr,
 	})
 
-	request := operations.ListServicesRequest{}
+	request := operations.ListServicesRequest{
+		PerPage: ptr.Of(100),
+	}
 	if query != "" {
 		request.Query = &query
 	}
@@ -70,14 +77,27 @@ func dataFireHydrantServices(ctx context.Context, d *schema.ResourceData, m inte
 		request.Labels = &labelsStr
 	}
 
-	servicesResponse, err := client.Sdk.CatalogEntries.ListServices(ctx, request)
-	if err != nil {
-		return diag.Errorf("Error reading services: %v", err)
+	servicesResponse, diags := pagination.Paginate(ctx, pagination.PaginateRequestOptions[operations.ListServicesRequest, components.ServiceEntity]{
+		Client:  client,
+		Request: &request,
+		SetRequestPageFunc: func(request *operations.ListServicesRequest, page *int) {
+			request.Page = page
+		},
+		GetPageFunc: func(ctx context.Context, client *firehydrant.APIClient, request *operations.ListServicesRequest) (pagination.PaginateResponse[components.ServiceEntity], diag.Diagnostics) {
+			response, err := client.Sdk.CatalogEntries.ListServices(ctx, *request)
+			if err != nil {
+				return nil, diag.Errorf("Error reading services: %v", err)
+			}
+			return response, nil
+		},
+	})
+	if diags.HasError() {
+		return diags
 	}
 
 	// Set the data source attributes to the values we got from the API
 	services := make([]interface{}, 0)
-	for _, service := range servicesResponse.Data {
+	for _, service := range servicesResponse {
 		// Unmarshal labels from SDK struct to map[string]string
 		labelsMap, err := unmarshalLabels(service.Labels)
 		if err != nil {
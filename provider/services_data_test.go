@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -12,8 +13,8 @@ import (
 func TestAccServicesDataSource_basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccServicesDataSourceConfig_basic(),
@@ -26,6 +27,29 @@ func TestAccServicesDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestOfflineServicesDataSource_AllPages(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	for i := 0; i < 250; i++ {
+		server.Seed("/v1/services", map[string]interface{}{"name": fmt.Sprintf("test-service-%03d", i)})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + `
+data "firehydrant_services" "all_services" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firehydrant_services.all_services", "services.#", "250"),
+					resource.TestCheckResourceAttr("data.firehydrant_services.all_services", "services.0.name", "test-service-000"),
+					resource.TestCheckResourceAttr("data.firehydrant_services.all_services", "services.249.name", "test-service-249"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServicesSet(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		servicesResource, ok := s.RootModule().Resources[name]
@@ -59,7 +83,7 @@ func testAccCheckServicesSet(name string) resource.TestCheckFunc {
 func testAccServicesDataSourceConfig_basic() string {
 	return `
 data "firehydrant_services" "all_services" {
-	query = "tf-test-shared"
+	query = "tf-acc-shared-"
 	labels = {
 		"test" = "shared"
 	}
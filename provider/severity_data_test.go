@@ -15,8 +15,8 @@ func TestAccSeverityDataSource_basic(t *testing.T) {
 	t.Parallel()
 	slug := "TESTSEV" + strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityDataSourceConfig_basic(slug),
@@ -38,8 +38,8 @@ func TestAccSeverityDataSource_allAttributes(t *testing.T) {
 	t.Parallel()
 	slug := "TESTSEV" + strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityDataSourceConfig_allAttributes(slug),
@@ -2,13 +2,11 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +19,9 @@ func resourceSeverity() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantSeverity,
 		ReadContext:   readResourceFireHydrantSeverity,
 		DeleteContext: deleteResourceFireHydrantSeverity,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: severityImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -81,7 +80,7 @@ func readResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceData
 	})
 	severityResponse, err := firehydrantAPIClient.Severities().Get(ctx, severityID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Severity %s no longer exists", severityID), map[string]interface{}{
 				"id": severityID,
 			})
@@ -170,7 +169,7 @@ func deleteResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 	})
 	err := firehydrantAPIClient.Severities().Delete(ctx, severityID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting severity %s: %v", severityID, err)
@@ -178,3 +177,10 @@ func deleteResourceFireHydrantSeverity(ctx context.Context, d *schema.ResourceDa
 
 	return diag.Diagnostics{}
 }
+
+// Severities are identified by their slug, so there is nothing to look up
+var severityImportLookup = importLookup{
+	resourceName: "severity",
+	fields:       []string{"slug"},
+	slugIsID:     true,
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,9 +20,9 @@ func TestAccSeverityResource_basic(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckSeverityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckSeverityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityResourceConfig_basic(rSlug),
@@ -44,9 +45,9 @@ func TestAccSeverityResource_update(t *testing.T) {
 	rSlugUpdated := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckSeverityResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckSeverityResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityResourceConfig_basic(rSlug),
@@ -92,8 +93,8 @@ func TestAccSeverityResource_validateSchemaAttributesSlug(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccSeverityResourceConfig_slugTooLong(rSlug),
@@ -112,8 +113,8 @@ func TestAccSeverityResourceImport_basic(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityResourceConfig_basic(rSlug),
@@ -132,8 +133,8 @@ func TestAccSeverityResourceImport_allAttributes(t *testing.T) {
 	rSlug := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSeverityResourceConfig_update(rSlug),
@@ -147,6 +148,34 @@ func TestAccSeverityResourceImport_allAttributes(t *testing.T) {
 	})
 }
 
+func TestOfflineSeverityResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	testFakeAPICase(t, server, "firehydrant_severity", "/v1/severities", []resource.TestStep{
+		{
+			Config: testAccSeverityResourceConfig_basic("OFFLINE"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_severity.test_severity", "id", "TESTSEVERITYOFFLINE"),
+				resource.TestCheckResourceAttr("firehydrant_severity.test_severity", "type", string(firehydrant.SeverityTypeUnexpectedDowntime)),
+			),
+		},
+		{
+			Config: testAccSeverityResourceConfig_update("RENAMED"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_severity.test_severity", "id", "TESTSEVERITYRENAMED"),
+				resource.TestCheckResourceAttr("firehydrant_severity.test_severity", "description", "test-description-RENAMED"),
+				resource.TestCheckResourceAttr("firehydrant_severity.test_severity", "type", string(firehydrant.SeverityTypeMaintenance)),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_severity.test_severity",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccCheckSeverityResourceExistsWithAttributes_basic(resourceSlug string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		severityResource, ok := s.RootModule().Resources[resourceSlug]
@@ -50,8 +50,8 @@ func TestSignalRuleDataSource_OneMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testSignalRuleDataSourceConfig_basic(),
@@ -89,8 +89,8 @@ func TestSignalRuleDataSource_MultipleMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testSignalRuleDataSourceConfig_exactMatch(),
@@ -128,8 +128,8 @@ func TestSignalRuleDataSource_MultipleMatchesNoExactMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testSignalRuleDataSourceConfig_exactMatch(),
@@ -161,8 +161,8 @@ func TestSignalRuleDataSource_NoMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testSignalRuleDataSourceConfig_basic(),
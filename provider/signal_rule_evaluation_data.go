@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSignalRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataFireHydrantSignalRuleEvaluation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"payloads": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Sample signals as JSON objects, e.g. jsonencode({ summary = \"...\", labels = { ... } })",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				},
+			},
+
+			// Optional
+			"expression": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"expression", "signal_rule_id"},
+				ValidateDiagFunc: validateCELExpression(signalRuleCELEnv, cel.BoolType),
+			},
+			"signal_rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"team_id"},
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"signal_rule_id"},
+			},
+
+			// Computed
+			"matches": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Whether the expression matches each of the payloads, in the same order",
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+		},
+	}
+}
+
+func dataFireHydrantSignalRuleEvaluation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	expression := d.Get("expression").(string)
+	if ruleID := d.Get("signal_rule_id").(string); ruleID != "" {
+		client := m.(*firehydrant.APIClient)
+		teamID := d.Get("team_id").(string)
+		tflog.Debug(ctx, fmt.Sprintf("Fetch signal rule: %s", ruleID), map[string]interface{}{
+			"id":      ruleID,
+			"team_id": teamID,
+		})
+
+		rule, err := client.Sdk.Signals.GetTeamSignalRule(ctx, teamID, ruleID)
+		if err != nil {
+			return diag.Errorf("Error fetching signal rule %s: %v", ruleID, err)
+		}
+		expression = stringValue(rule.GetExpression())
+	}
+
+	var payloads []string
+	for _, payload := range d.Get("payloads").([]interface{}) {
+		p, _ := payload.(string)
+		payloads = append(payloads, p)
+	}
+
+	matches, diags := evaluateSignalRule(expression, payloads)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := map[string]interface{}{
+		"expression": expression,
+		"matches":    matches,
+	}
+	for key, val := range attributes {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("Error setting %s: %v", key, err)
+		}
+	}
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+// evaluateSignalRule evaluates a signal rule expression locally against each of the payloads,
+// which are signals as JSON. A payload the expression can't be evaluated for, e.g. because it
+// refers to a label the signal doesn't have, doesn't match and is reported as a warning.
+func evaluateSignalRule(expression string, payloads []string) ([]bool, diag.Diagnostics) {
+	env, err := signalRuleCELEnv()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, diag.Errorf("Invalid CEL expression: %v", issues.Err())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, diag.Errorf("Invalid CEL expression: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	matches := make([]bool, len(payloads))
+	for i, payload := range payloads {
+		var signal celSignal
+		if err := json.Unmarshal([]byte(payload), &signal); err != nil {
+			return nil, diag.Errorf("Error decoding payload %d: %v", i, err)
+		}
+
+		result, _, err := program.Eval(map[string]interface{}{"signal": signal})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Payload %d does not match", i),
+				Detail:   fmt.Sprintf("Evaluating the expression failed: %v", err),
+			})
+			continue
+		}
+		matches[i], _ = result.Value().(bool)
+	}
+	return matches, diags
+}
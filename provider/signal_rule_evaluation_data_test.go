@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEvaluateSignalRule(t *testing.T) {
+	payloads := []string{
+		`{"summary": "[Triggered] CPU high", "level": "ERROR", "labels": {"service": "payments"}, "tags": ["prod"]}`,
+		`{"summary": "[Recovered] CPU high", "level": "INFO", "labels": {"service": "payments"}}`,
+		`{"summary": "[Triggered] Disk full", "level": "ERROR"}`,
+	}
+
+	cases := []struct {
+		name       string
+		expression string
+		matches    []bool
+		warnings   int
+	}{
+		{name: "summary", expression: `signal.summary.startsWith("[Triggered]")`, matches: []bool{true, false, true}},
+		{name: "tags", expression: `"prod" in signal.tags`, matches: []bool{true, false, false}},
+		{name: "missing label", expression: `signal.labels["service"] == "payments" && signal.level == "ERROR"`, matches: []bool{true, false, false}, warnings: 1},
+		{name: "has label", expression: `has(signal.labels.service) && signal.labels.service == "payments"`, matches: []bool{true, true, false}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			matches, diags := evaluateSignalRule(c.expression, payloads)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(diags) != c.warnings {
+				t.Fatalf("expected %d warnings, got %v", c.warnings, diags)
+			}
+			if fmt.Sprint(matches) != fmt.Sprint(c.matches) {
+				t.Fatalf("expected matches to be %v, got %v", c.matches, matches)
+			}
+		})
+	}
+}
+
+func TestOfflineSignalRuleEvaluation(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Philadelphia"})[0]["id"].(string)
+	ruleID := server.Seed(fmt.Sprintf("/v1/teams/%s/signal_rules", teamID), map[string]interface{}{
+		"name":        "Triggered",
+		"expression":  `signal.summary.startsWith("[Triggered]")`,
+		"target_type": "User",
+		"target_id":   "user-1",
+	})[0]["id"].(string)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeAPIProviderConfig(server) + `
+data "firehydrant_signal_rule_evaluation" "test" {
+  expression = "signal.summry == 'x'"
+  payloads   = [jsonencode({ summary = "x" })]
+}
+`,
+				ExpectError: regexp.MustCompile(`undefined field 'summry'`),
+			},
+			{
+				Config: testFakeAPIProviderConfig(server) + fmt.Sprintf(`
+data "firehydrant_signal_rule_evaluation" "test" {
+  team_id        = %q
+  signal_rule_id = %q
+  payloads = [
+    jsonencode({ summary = "[Triggered] CPU high" }),
+    jsonencode({ summary = "[Recovered] CPU high" }),
+  ]
+}
+`, teamID, ruleID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firehydrant_signal_rule_evaluation.test", "expression", `signal.summary.startsWith("[Triggered]")`),
+					resource.TestCheckResourceAttr("data.firehydrant_signal_rule_evaluation.test", "matches.#", "2"),
+					resource.TestCheckResourceAttr("data.firehydrant_signal_rule_evaluation.test", "matches.0", "true"),
+					resource.TestCheckResourceAttr("data.firehydrant_signal_rule_evaluation.test", "matches.1", "false"),
+				),
+			},
+		},
+	})
+}
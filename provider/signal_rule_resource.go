@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/google/cel-go/cel"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,8 +23,9 @@ func resourceSignalRule() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantSignalRule,
 		ReadContext:   readResourceFireHydrantSignalRule,
 		DeleteContext: deleteResourceFireHydrantSignalRule,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importResourceFireHydrantSignalRule,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -35,8 +39,9 @@ func resourceSignalRule() *schema.Resource {
 				Required: true,
 			},
 			"expression": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCELExpression(signalRuleCELEnv, cel.BoolType),
 			},
 			"target_type": {
 				Type:     schema.TypeString,
@@ -103,7 +108,7 @@ func readResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceDa
 
 	signalRule, err := client.Sdk.Signals.GetTeamSignalRule(ctx, teamID, id)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Signal rule %s no longer exists", id), map[string]interface{}{
 				"id":      id,
 				"team_id": teamID,
@@ -286,8 +291,57 @@ func deleteResourceFireHydrantSignalRule(ctx context.Context, d *schema.Resource
 	})
 	err := client.Sdk.Signals.DeleteTeamSignalRule(ctx, d.Get("team_id").(string), d.Id())
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			return nil
+		}
 		return diag.Errorf("Error deleting signal rule %s: %v", d.Id(), err)
 	}
 
 	return diag.Diagnostics{}
 }
+
+func importResourceFireHydrantSignalRule(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	team_id, id, err := resourceFireHydrantSignalRuleParseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	id, err = signalRuleImportLookup(team_id).resolve(ctx, m.(*firehydrant.APIClient), id)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", team_id)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceFireHydrantSignalRuleParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected Team_ID:Signal_Rule_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// signalRuleImportLookup resolves Team_ID:name=<name> imports within the given team
+func signalRuleImportLookup(teamID string) importLookup {
+	return importLookup{
+		resourceName: "signal rule",
+		fields:       []string{"name"},
+		list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+			request := operations.ListTeamSignalRulesRequest{TeamID: teamID, Query: &value, PerPage: ptr.Of(importLookupPerPage)}
+			return listImportCandidates(ctx, client, &request,
+				func(request *operations.ListTeamSignalRulesRequest, page *int) { request.Page = page },
+				func(ctx context.Context, request *operations.ListTeamSignalRulesRequest) (pagination.PaginateResponse[components.SignalsAPIRuleEntity], error) {
+					return client.Sdk.Signals.ListTeamSignalRules(ctx, request.TeamID, request.Query, request.Page, request.PerPage)
+				},
+				func(rule components.SignalsAPIRuleEntity) importCandidate {
+					return importCandidate{ID: stringValue(rule.GetID()), Name: stringValue(rule.GetName())}
+				},
+			)
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -24,9 +25,9 @@ func TestAccFireHydrantSignalRule_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFireHydrantSignalRuleConfigBasic(rName, "MEDIUM", sharedTeamID),
@@ -58,6 +59,18 @@ func TestAccFireHydrantSignalRule_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("firehydrant_signal_rule.test", "target_is_pageable"),
 				),
 			},
+			{
+				ResourceName: "firehydrant_signal_rule.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["firehydrant_signal_rule.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "firehydrant_signal_rule.test")
+					}
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["id"]), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -67,9 +80,9 @@ func TestAccFireHydrantSignalRule_invalidPriority(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccFireHydrantSignalRuleConfigBasic(rName, "INVALID", sharedTeamID),
@@ -84,9 +97,9 @@ func TestAccFireHydrantSignalRule_createIncidentConditionWhen(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFireHydrantSignalRuleConfigWithIncidentCondition(rName, "WHEN_ALWAYS", "PT30M", sharedTeamID),
@@ -103,6 +116,37 @@ func TestAccFireHydrantSignalRule_createIncidentConditionWhen(t *testing.T) {
 	})
 }
 
+func TestOfflineSignalRuleResource(t *testing.T) {
+	t.Setenv("EXISTING_USER_EMAIL", "responder@example.com")
+	server := fakeapi.NewServer()
+	defer server.Close()
+	teamID := server.Seed("/v1/teams", map[string]interface{}{"name": "Payments"})[0]["id"].(string)
+	userID := server.Seed("/v1/users", map[string]interface{}{"name": "Responder", "email": "responder@example.com"})[0]["id"].(string)
+
+	testFakeAPICase(t, server, "firehydrant_signal_rule", "/v1/teams/{team_id}/signal_rules", []resource.TestStep{
+		{
+			Config: testAccFireHydrantSignalRuleConfigBasic("offline", "HIGH", teamID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_signal_rule.test", "id"),
+				resource.TestCheckResourceAttr("firehydrant_signal_rule.test", "target_id", userID),
+				resource.TestCheckResourceAttr("firehydrant_signal_rule.test", "notification_priority_override", "HIGH"),
+			),
+		},
+		{
+			Config: testAccFireHydrantSignalRuleConfigBasic("offline", "LOW", teamID),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_signal_rule.test", "notification_priority_override", "LOW"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_signal_rule.test",
+			ImportStateIdFunc: testFakeAPIImportStateIDForTeam("firehydrant_signal_rule.test"),
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccFireHydrantSignalRuleConfigBasic(rName, priority, sharedTeamID string) string {
 	existingUser := os.Getenv("EXISTING_USER_EMAIL")
 	if existingUser == "" {
@@ -116,7 +160,7 @@ func testAccFireHydrantSignalRuleConfigBasic(rName, priority, sharedTeamID strin
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-%s"
+		name = "tf-acc-signal-rule-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -131,9 +175,9 @@ func TestAccFireHydrantSignalRule_IncidentTypeIDMissing(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFireHydrantSignalRuleConfigIncidentTypeIDMissing(rName, sharedTeamID),
@@ -152,9 +196,9 @@ func TestAccFireHydrantSignalRule_NotificationPriorityAddRemove(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFireHydrantSignalRuleConfigWithPriority(rName, "HIGH", sharedTeamID),
@@ -189,9 +233,9 @@ func TestAccFireHydrantSignalRule_withoutNotificationPriorityOverride(t *testing
 	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckFireHydrantSignalRuleDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckFireHydrantSignalRuleDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccFireHydrantSignalRuleConfigWithoutPriority(rName, sharedTeamID),
@@ -210,7 +254,7 @@ func TestAccFireHydrantSignalRule_withoutNotificationPriorityOverride(t *testing
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFireHydrantSignalRuleExists("firehydrant_signal_rule.test"),
 					resource.TestCheckNoResourceAttr("firehydrant_signal_rule.test", "notification_priority_override"),
-					resource.TestCheckResourceAttr("firehydrant_signal_rule.test", "name", fmt.Sprintf("test-signal-rule-updated-%s", rName)),
+					resource.TestCheckResourceAttr("firehydrant_signal_rule.test", "name", fmt.Sprintf("tf-acc-signal-rule-updated-%s", rName)),
 				),
 			},
 		},
@@ -230,7 +274,7 @@ func testAccFireHydrantSignalRuleConfigWithPriority(rName, priority, sharedTeamI
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-%s"
+		name = "tf-acc-signal-rule-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -253,7 +297,7 @@ func testAccFireHydrantSignalRuleConfigWithoutPriority(rName, sharedTeamID strin
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-%s"
+		name = "tf-acc-signal-rule-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -275,7 +319,7 @@ func testAccFireHydrantSignalRuleConfigWithoutPriorityUpdated(rName, sharedTeamI
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-updated-%s"
+		name = "tf-acc-signal-rule-updated-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -297,7 +341,7 @@ func testAccFireHydrantSignalRuleConfigIncidentTypeIDMissing(rName, sharedTeamID
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-%s"
+		name = "tf-acc-signal-rule-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -320,7 +364,7 @@ func testAccFireHydrantSignalRuleConfigWithIncidentCondition(rName, condition, e
 
 	resource "firehydrant_signal_rule" "test" {
 		team_id = "%s"
-		name = "test-signal-rule-%s"
+		name = "tf-acc-signal-rule-%s"
 		expression = "signal.summary == 'test-signal-summary-%s'"
 		target_type = "User"
 		target_id = data.firehydrant_user.test_user.id
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// signalsTargetTypes are the types an escalation policy step or a signal rule can target
+var signalsTargetTypes = []string{
+	string(components.CreateTeamEscalationPolicyTypeOnCallSchedule),
+	string(components.CreateTeamEscalationPolicyTypeUser),
+	string(components.CreateTeamEscalationPolicyTypeSlackChannel),
+	string(components.CreateTeamEscalationPolicyTypeMicrosoftTeamsChannel),
+	string(components.CreateTeamEscalationPolicyTypeEntireTeam),
+	string(components.CreateTeamEscalationPolicyTypeWebhook),
+	string(components.CreateTeamSignalRuleTargetTypeEscalationPolicy),
+	string(components.CreateTeamEscalationPolicyTargetTypeTeam),
+}
+
+var signalsTargetAttributeTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+// signalsTargetFunction builds the target of an escalation policy step, handoff step or
+// signal rule
+type signalsTargetFunction struct{}
+
+var _ function.Function = signalsTargetFunction{}
+
+func newSignalsTargetFunction() function.Function {
+	return signalsTargetFunction{}
+}
+
+func (f signalsTargetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "signals_target"
+}
+
+func (f signalsTargetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the target of an escalation policy or signal rule",
+		Description: "Returns an object with the `type` and `id` of a Signals target. The type is one of " +
+			strings.Join(signalsTargetTypes, ", ") + ", matched regardless of case and underscores, e.g. `on_call_schedule`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "type", Description: "Type of the target"},
+			function.StringParameter{Name: "id", Description: "ID of the target"},
+		},
+		Return: function.ObjectReturn{AttributeTypes: signalsTargetAttributeTypes},
+	}
+}
+
+func (f signalsTargetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var targetType, id string
+	resp.Error = req.Arguments.Get(ctx, &targetType, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	canonical := ""
+	for _, t := range signalsTargetTypes {
+		if strings.EqualFold(t, strings.ReplaceAll(targetType, "_", "")) {
+			canonical = t
+			break
+		}
+	}
+	if canonical == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("type must be one of %s, got: %s", strings.Join(signalsTargetTypes, ", "), targetType))
+		return
+	}
+	if strings.TrimSpace(id) == "" {
+		resp.Error = function.NewArgumentFuncError(1, "id must not be empty")
+		return
+	}
+
+	target, diags := types.ObjectValue(signalsTargetAttributeTypes, map[string]attr.Value{
+		"type": types.StringValue(canonical),
+		"id":   types.StringValue(id),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, target)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSignalsTargetFunction(t *testing.T) {
+	tests := map[string]struct {
+		targetType, id string
+		expected       string
+		err            string
+	}{
+		"canonical type":   {targetType: "OnCallSchedule", id: "schedule-1", expected: "OnCallSchedule"},
+		"snake case type":  {targetType: "escalation_policy", id: "policy-1", expected: "EscalationPolicy"},
+		"lower case type":  {targetType: "user", id: "user-1", expected: "User"},
+		"unsupported type": {targetType: "Pager", id: "pager-1", err: "type must be one of"},
+		"empty id":         {targetType: "Team", id: " ", err: "id must not be empty"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := testCallFunction(t, "signals_target", tftypes.NewValue(tftypes.String, tc.targetType), tftypes.NewValue(tftypes.String, tc.id))
+			if tc.err != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, funcErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			var target map[string]tftypes.Value
+			if err := result.As(&target); err != nil {
+				t.Fatal(err)
+			}
+			var gotType, gotID string
+			_ = target["type"].As(&gotType)
+			_ = target["id"].As(&gotID)
+			if gotType != tc.expected || gotID != tc.id {
+				t.Fatalf("expected %s %s, got %s %s", tc.expected, tc.id, gotType, gotID)
+			}
+		})
+	}
+}
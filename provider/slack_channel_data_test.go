@@ -77,3 +77,35 @@ func TestOfflineSlackChannelsSlackChannel_missing(t *testing.T) {
 		t.Fatalf("didnt fail on reading slack channel: %v", d)
 	}
 }
+
+func TestOfflineSlackChannelsSlackChannel_multiplePages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") != "2" {
+			w.Write([]byte(`{
+  "data": [{"id":"00000000-0000-4000-8000-000000000000","name":"#team-rocket","slack_channel_id":"C01010101Z"}],
+  "pagination": {"count":2,"page":1,"items":1,"pages":2,"last":2,"prev":null,"next":2}
+}`))
+			return
+		}
+		w.Write([]byte(`{
+  "data": [{"id":"00000000-0000-4000-8000-000000000001","name":"#team-rocket","slack_channel_id":"C02020202Z"}],
+  "pagination": {"count":2,"page":2,"items":1,"pages":2,"last":2,"prev":1,"next":null}
+}`))
+	}))
+	defer ts.Close()
+
+	c, err := firehydrant.NewRestClient("test-token-very-authorized", firehydrant.WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatalf("Received error initializing API client: %s", err.Error())
+		return
+	}
+	r := schema.TestResourceDataRaw(t, dataSourceSlackChannel().Schema, map[string]interface{}{
+		"slack_channel_name": "team-rocket",
+	})
+
+	// The second match is only on the second page
+	d := dataFireHydrantSlackChannelRead(context.Background(), r, c)
+	if !d.HasError() {
+		t.Fatalf("didnt fail on a slack channel name matching more than one channel: %v", r.Id())
+	}
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// restrictionTimeWithoutSeconds matches restriction times written as HH:MM
+var restrictionTimeWithoutSeconds = regexp.MustCompile(`^\d{2}:\d{2}$`)
+
+// normalizeRestrictionDay returns a restriction day the way the API returns it, e.g. monday
+func normalizeRestrictionDay(day string) string {
+	return strings.ToLower(strings.TrimSpace(day))
+}
+
+// normalizeRestrictionTime returns a restriction time the way the API returns it, HH:MM:SS
+func normalizeRestrictionTime(t string) string {
+	t = strings.TrimSpace(t)
+	if restrictionTimeWithoutSeconds.MatchString(t) {
+		return t + ":00"
+	}
+	return t
+}
@@ -2,9 +2,9 @@ package provider
 
 import (
 	"context"
-	"errors"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,8 +17,9 @@ func resourceStatusUpdateTemplate() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantStatusUpdateTemplate,
 		ReadContext:   readResourceFireHydrantStatusUpdateTemplate,
 		DeleteContext: deleteResourceFireHydrantStatusUpdateTemplate,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: statusUpdateTemplateImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,7 +47,7 @@ func readResourceFireHydrantStatusUpdateTemplate(ctx context.Context, d *schema.
 
 	template, err := firehydrantAPIClient.StatusUpdateTemplates().Get(ctx, id)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, "Status update template %s does not exist", map[string]interface{}{"id": id})
 			d.SetId("")
 			return nil
@@ -110,8 +111,32 @@ func deleteResourceFireHydrantStatusUpdateTemplate(ctx context.Context, d *schem
 	tflog.Debug(ctx, "Delete status update template", map[string]interface{}{"id": d.Id()})
 	err := firehydrantAPIClient.StatusUpdateTemplates().Delete(ctx, d.Id())
 	if err != nil {
+		if firehydrant.IsNotFound(err) {
+			return nil
+		}
 		return diag.Errorf("Error deleting status update template %s: %v", d.Id(), err)
 	}
 
 	return diag.Diagnostics{}
 }
+
+var statusUpdateTemplateImportLookup = importLookup{
+	resourceName: "status update template",
+	fields:       []string{"name"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := firehydrant.StatusUpdateTemplateQuery{PerPage: importLookupPerPage}
+		return listImportCandidates(ctx, client, &request,
+			func(request *firehydrant.StatusUpdateTemplateQuery, page *int) { request.Page = *page },
+			func(ctx context.Context, request *firehydrant.StatusUpdateTemplateQuery) (pagination.PaginateResponse[firehydrant.StatusUpdateTemplateResponse], error) {
+				response, err := client.StatusUpdateTemplates().List(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				return pagination.RESTPage[firehydrant.StatusUpdateTemplateResponse]{Data: response.StatusUpdateTemplates, Pagination: response.Pagination}, nil
+			},
+			func(template firehydrant.StatusUpdateTemplateResponse) importCandidate {
+				return importCandidate{ID: template.ID, Name: template.Name}
+			},
+		)
+	},
+}
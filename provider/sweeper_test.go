@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/firehydrant/firehydrant-go-sdk/models/components"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// sweepNamePrefix is the prefix acceptance tests give the names of the objects they create,
+// followed by a random suffix. Anything named like this is left over from a test run that
+// didn't clean up after itself, except the shared resources named with
+// sharedTestResourcePrefix, which other test runs may still be using.
+const sweepNamePrefix = "tf-acc-"
+
+// sweepSlugPrefixes are the prefixes of the slugs acceptance tests give severities and
+// priorities, which have no name. Only slugs with a random suffix are swept, so the
+// TESTPRIORITY fixture described in TESTS.md is kept.
+var sweepSlugPrefixes = []string{"TESTSEVERITY", "TESTPRIORITY"}
+
+// Sweepers run with `go test ./provider -v -sweep=all`. FireHydrant has no regions, so
+// the value of -sweep is ignored. Dependencies run first, which deletes objects before
+// the objects they reference: signal rules → escalation policies → rotations →
+// on-call schedules → teams.
+func init() {
+	resource.AddTestSweepers("firehydrant_custom_event_source", &resource.Sweeper{
+		Name: "firehydrant_custom_event_source",
+		F:    sweepCustomEventSources,
+	})
+	resource.AddTestSweepers("firehydrant_environment", &resource.Sweeper{
+		Name: "firehydrant_environment",
+		F: sweepImportLookup(environmentImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.CatalogEntries.DeleteEnvironment(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_escalation_policy", &resource.Sweeper{
+		Name:         "firehydrant_escalation_policy",
+		Dependencies: []string{"firehydrant_signal_rule"},
+		F: sweepTeamImportLookup(escalationPolicyImportLookup, func(ctx context.Context, client *firehydrant.APIClient, teamID, id string) error {
+			return client.Sdk.Signals.DeleteTeamEscalationPolicy(ctx, teamID, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_functionality", &resource.Sweeper{
+		Name: "firehydrant_functionality",
+		F: sweepImportLookup(functionalityImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.CatalogEntries.DeleteFunctionality(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_inbound_email", &resource.Sweeper{
+		Name: "firehydrant_inbound_email",
+		F: sweepImportLookup(inboundEmailImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.Signals.DeleteSignalsEmailTarget(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_incident_role", &resource.Sweeper{
+		Name: "firehydrant_incident_role",
+		F: sweepImportLookup(incidentRoleImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.IncidentSettings.DeleteIncidentRole(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_incident_type", &resource.Sweeper{
+		Name: "firehydrant_incident_type",
+		F: sweepImportLookup(incidentTypeImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.IncidentSettings.DeleteIncidentType(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_lifecycle_milestone", &resource.Sweeper{
+		Name: "firehydrant_lifecycle_milestone",
+		F: sweepImportLookup(lifecycleMilestoneImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.IncidentSettings.DeleteLifecycleMilestone(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_on_call_schedule", &resource.Sweeper{
+		Name:         "firehydrant_on_call_schedule",
+		Dependencies: []string{"firehydrant_rotation"},
+		F: sweepTeamImportLookup(onCallScheduleImportLookup, func(ctx context.Context, client *firehydrant.APIClient, teamID, id string) error {
+			return client.Sdk.Signals.DeleteTeamOnCallSchedule(ctx, teamID, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_priority", &resource.Sweeper{
+		Name: "firehydrant_priority",
+		F:    sweepPriorities,
+	})
+	resource.AddTestSweepers("firehydrant_role", &resource.Sweeper{
+		Name: "firehydrant_role",
+		F: sweepImportLookup(roleImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.Roles.DeleteRole(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_rotation", &resource.Sweeper{
+		Name:         "firehydrant_rotation",
+		Dependencies: []string{"firehydrant_escalation_policy"},
+		F:            sweepRotations,
+	})
+	resource.AddTestSweepers("firehydrant_runbook", &resource.Sweeper{
+		Name: "firehydrant_runbook",
+		F: sweepImportLookup(runbookImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Runbooks().Delete(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_service", &resource.Sweeper{
+		Name:         "firehydrant_service",
+		Dependencies: []string{"firehydrant_service_dependency", "firehydrant_functionality"},
+		F: sweepImportLookup(serviceImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.CatalogEntries.DeleteService(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_service_dependency", &resource.Sweeper{
+		Name: "firehydrant_service_dependency",
+		F:    sweepServiceDependencies,
+	})
+	resource.AddTestSweepers("firehydrant_severity", &resource.Sweeper{
+		Name: "firehydrant_severity",
+		F:    sweepSeverities,
+	})
+	resource.AddTestSweepers("firehydrant_signal_rule", &resource.Sweeper{
+		Name: "firehydrant_signal_rule",
+		F: sweepTeamImportLookup(signalRuleImportLookup, func(ctx context.Context, client *firehydrant.APIClient, teamID, id string) error {
+			return client.Sdk.Signals.DeleteTeamSignalRule(ctx, teamID, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_status_update_template", &resource.Sweeper{
+		Name: "firehydrant_status_update_template",
+		F: sweepImportLookup(statusUpdateTemplateImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.StatusUpdateTemplates().Delete(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_task_list", &resource.Sweeper{
+		Name: "firehydrant_task_list",
+		F: sweepImportLookup(taskListImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.TaskLists().Delete(ctx, id)
+		}),
+	})
+	resource.AddTestSweepers("firehydrant_team", &resource.Sweeper{
+		Name:         "firehydrant_team",
+		Dependencies: []string{"firehydrant_on_call_schedule", "firehydrant_inbound_email", "firehydrant_service"},
+		F: sweepImportLookup(teamImportLookup, func(ctx context.Context, client *firehydrant.APIClient, id string) error {
+			return client.Sdk.Teams.DeleteTeam(ctx, id)
+		}),
+	})
+}
+
+// isSweepableName reports whether name was given to an object by an acceptance test
+func isSweepableName(name string) bool {
+	return strings.HasPrefix(name, sweepNamePrefix) && !strings.HasPrefix(name, sharedTestResourcePrefix)
+}
+
+func TestIsSweepableName(t *testing.T) {
+	tests := map[string]bool{
+		"tf-acc-team-a1b2c3d4":           true,
+		"tf-acc-milestone-a1b2c3d4":      true,
+		"tf-acc-shared-default-a1b2c3d4": false,
+		"test-team-a1b2c3d4":             false,
+		"updated-role-a1b2c3d4":          false,
+		"Payments":                       false,
+	}
+	for name, expected := range tests {
+		if got := isSweepableName(name); got != expected {
+			t.Errorf("Expected isSweepableName(%q) to be %t, got %t", name, expected, got)
+		}
+	}
+}
+
+// isSweepableSlug reports whether slug was given to a severity or priority by an acceptance test
+func isSweepableSlug(slug string) bool {
+	for _, prefix := range sweepSlugPrefixes {
+		if strings.HasPrefix(slug, prefix) && len(slug) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweeper collects the errors of a sweep, so that one object that can't be deleted
+// doesn't keep the rest from being swept
+type sweeper struct {
+	resourceName string
+	errs         []error
+}
+
+// delete deletes an object, treating an object that is already gone as deleted
+func (s *sweeper) delete(label string, del func() error) {
+	log.Printf("[INFO] Sweeping %s %s", s.resourceName, label)
+	if err := del(); err != nil && !firehydrant.IsNotFound(err) {
+		s.errs = append(s.errs, fmt.Errorf("could not sweep %s %s: %w", s.resourceName, label, err))
+	}
+}
+
+func (s *sweeper) fail(err error) {
+	s.errs = append(s.errs, err)
+}
+
+func (s *sweeper) err() error {
+	return errors.Join(s.errs...)
+}
+
+// sweepCandidates lists the objects of an import lookup that isSweepableName
+func sweepCandidates(ctx context.Context, client *firehydrant.APIClient, l importLookup) ([]importCandidate, error) {
+	found, err := l.list(ctx, client, "name", sweepNamePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", l.resourceName, err)
+	}
+	var candidates []importCandidate
+	for _, c := range found {
+		if isSweepableName(c.Name) {
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates, nil
+}
+
+// sweepTeamIDs returns the IDs of every team, since tests also create objects under
+// teams they didn't create themselves
+func sweepTeamIDs(ctx context.Context, client *firehydrant.APIClient) ([]string, error) {
+	// A slug lookup isn't narrowed server side, so this lists every team
+	teams, err := teamImportLookup.list(ctx, client, "slug", "")
+	if err != nil {
+		return nil, fmt.Errorf("could not list teams: %w", err)
+	}
+
+	ids := make([]string, 0, len(teams))
+	for _, team := range teams {
+		ids = append(ids, team.ID)
+	}
+	return ids, nil
+}
+
+// sweepImportLookup returns a sweeper function deleting the objects found through an
+// import lookup that were created by acceptance tests
+func sweepImportLookup(l importLookup, del func(ctx context.Context, client *firehydrant.APIClient, id string) error) resource.SweeperFunc {
+	return func(_ string) error {
+		ctx := context.Background()
+		client, err := getAccTestClient()
+		if err != nil {
+			return err
+		}
+
+		candidates, err := sweepCandidates(ctx, client, l)
+		if err != nil {
+			return err
+		}
+
+		s := &sweeper{resourceName: l.resourceName}
+		for _, c := range candidates {
+			s.delete(fmt.Sprintf("%s (%s)", c.Name, c.ID), func() error { return del(ctx, client, c.ID) })
+		}
+		return s.err()
+	}
+}
+
+// sweepTeamImportLookup is sweepImportLookup for objects that belong to a team
+func sweepTeamImportLookup(lookup func(teamID string) importLookup, del func(ctx context.Context, client *firehydrant.APIClient, teamID, id string) error) resource.SweeperFunc {
+	return func(_ string) error {
+		ctx := context.Background()
+		client, err := getAccTestClient()
+		if err != nil {
+			return err
+		}
+
+		teamIDs, err := sweepTeamIDs(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		s := &sweeper{resourceName: lookup("").resourceName}
+		for _, teamID := range teamIDs {
+			candidates, err := sweepCandidates(ctx, client, lookup(teamID))
+			if err != nil {
+				s.fail(err)
+				continue
+			}
+			for _, c := range candidates {
+				s.delete(fmt.Sprintf("%s (%s:%s)", c.Name, teamID, c.ID), func() error { return del(ctx, client, teamID, c.ID) })
+			}
+		}
+		return s.err()
+	}
+}
+
+// sweepRotations deletes test rotations from every schedule, including schedules that
+// aren't swept themselves such as the shared test schedule
+func sweepRotations(_ string) error {
+	ctx := context.Background()
+	client, err := getAccTestClient()
+	if err != nil {
+		return err
+	}
+
+	teamIDs, err := sweepTeamIDs(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	s := &sweeper{resourceName: "rotation"}
+	for _, teamID := range teamIDs {
+		// An empty query lists every schedule of the team
+		schedules, err := onCallScheduleImportLookup(teamID).list(ctx, client, "name", "")
+		if err != nil {
+			s.fail(fmt.Errorf("could not list on-call schedules for team %s: %w", teamID, err))
+			continue
+		}
+		for _, schedule := range schedules {
+			response, err := client.Sdk.Signals.GetTeamOnCallSchedule(ctx, teamID, schedule.ID, nil, nil)
+			if err != nil {
+				s.fail(fmt.Errorf("could not get on-call schedule %s: %w", schedule.ID, err))
+				continue
+			}
+			for _, rotation := range response.GetRotations() {
+				id, name := stringValue(rotation.GetID()), stringValue(rotation.GetName())
+				if !isSweepableName(name) {
+					continue
+				}
+				s.delete(fmt.Sprintf("%s (%s)", name, id), func() error {
+					return client.Sdk.Signals.DeleteOnCallScheduleRotation(ctx, id, teamID, schedule.ID)
+				})
+			}
+		}
+	}
+	return s.err()
+}
+
+// sweepServiceDependencies deletes the dependencies of the services created by acceptance tests
+func sweepServiceDependencies(_ string) error {
+	ctx := context.Background()
+	client, err := getAccTestClient()
+	if err != nil {
+		return err
+	}
+
+	services, err := sweepCandidates(ctx, client, serviceImportLookup)
+	if err != nil {
+		return err
+	}
+
+	s := &sweeper{resourceName: "service dependency"}
+	for _, service := range services {
+		response, err := client.Sdk.CatalogEntries.GetServiceDependencies(ctx, service.ID, nil)
+		if err != nil {
+			s.fail(fmt.Errorf("could not get dependencies of service %s: %w", service.ID, err))
+			continue
+		}
+
+		var ids []string
+		for _, dependency := range response.GetChildServiceDependencies() {
+			ids = append(ids, stringValue(dependency.GetID()))
+		}
+		for _, dependency := range response.GetParentServiceDependencies() {
+			ids = append(ids, stringValue(dependency.GetID()))
+		}
+		for _, id := range ids {
+			s.delete(fmt.Sprintf("%s of service %s", id, service.Name), func() error {
+				return client.ServiceDependencies().Delete(ctx, id)
+			})
+		}
+	}
+	return s.err()
+}
+
+func sweepSeverities(_ string) error {
+	ctx := context.Background()
+	client, err := getAccTestClient()
+	if err != nil {
+		return err
+	}
+
+	request := operations.ListSeveritiesRequest{PerPage: ptr.Of(importLookupPerPage)}
+	severities, err := listImportCandidates(ctx, client, &request,
+		func(request *operations.ListSeveritiesRequest, page *int) { request.Page = page },
+		func(ctx context.Context, request *operations.ListSeveritiesRequest) (pagination.PaginateResponse[components.SeverityEntity], error) {
+			return client.Sdk.IncidentSettings.ListSeverities(ctx, request.Page, request.PerPage)
+		},
+		func(severity components.SeverityEntity) importCandidate {
+			return importCandidate{ID: stringValue(severity.GetSlug()), Slug: stringValue(severity.GetSlug())}
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not list severities: %w", err)
+	}
+
+	s := &sweeper{resourceName: "severity"}
+	for _, severity := range severities {
+		if !isSweepableSlug(severity.Slug) {
+			continue
+		}
+		s.delete(severity.Slug, func() error { return client.Severities().Delete(ctx, severity.Slug) })
+	}
+	return s.err()
+}
+
+func sweepPriorities(_ string) error {
+	ctx := context.Background()
+	client, err := getAccTestClient()
+	if err != nil {
+		return err
+	}
+
+	request := firehydrant.PriorityQuery{PerPage: importLookupPerPage}
+	priorities, err := listImportCandidates(ctx, client, &request,
+		func(request *firehydrant.PriorityQuery, page *int) { request.Page = *page },
+		func(ctx context.Context, request *firehydrant.PriorityQuery) (pagination.PaginateResponse[firehydrant.PriorityResponse], error) {
+			response, err := client.Priorities().List(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			return pagination.RESTPage[firehydrant.PriorityResponse]{Data: response.Priorities, Pagination: response.Pagination}, nil
+		},
+		func(priority firehydrant.PriorityResponse) importCandidate {
+			return importCandidate{ID: priority.Slug, Slug: priority.Slug}
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not list priorities: %w", err)
+	}
+
+	s := &sweeper{resourceName: "priority"}
+	for _, priority := range priorities {
+		if !isSweepableSlug(priority.Slug) {
+			continue
+		}
+		s.delete(priority.Slug, func() error { return client.Sdk.IncidentSettings.DeletePriority(ctx, priority.Slug) })
+	}
+	return s.err()
+}
+
+func sweepCustomEventSources(_ string) error {
+	ctx := context.Background()
+	client, err := getAccTestClient()
+	if err != nil {
+		return err
+	}
+
+	transposers, err := customEventSourceImportLookup.list(ctx, client, "slug", sweepNamePrefix)
+	if err != nil {
+		return fmt.Errorf("could not list event sources: %w", err)
+	}
+
+	// Tests name event sources freely, only their slug has the prefix
+	s := &sweeper{resourceName: "custom event source"}
+	for _, transposer := range transposers {
+		if !strings.HasPrefix(transposer.Slug, sweepNamePrefix) {
+			continue
+		}
+		s.delete(transposer.Slug, func() error { return client.Sdk.Signals.DeleteSignalsEventSource(ctx, transposer.Slug) })
+	}
+	return s.err()
+}
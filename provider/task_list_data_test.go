@@ -13,15 +13,15 @@ func TestAccTaskListDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListDataSourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rName)),
+						"data.firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_task_list.test_task_list", "task_list_items.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -37,15 +37,15 @@ func TestAccTaskListDataSource_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListDataSourceConfig_allAttributes(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"data.firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rName)),
+						"data.firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"data.firehydrant_task_list.test_task_list", "description", fmt.Sprintf("test-description-%s", rName)),
 					resource.TestCheckResourceAttr(
@@ -67,7 +67,7 @@ func TestAccTaskListDataSource_allAttributes(t *testing.T) {
 func testAccTaskListDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_task_list" "test_task_list" {
-  name = "test-task-list-%s"
+  name = "tf-acc-task-list-%s"
 
   task_list_items {
     summary = "test-summary1-%s"
@@ -82,7 +82,7 @@ data "firehydrant_task_list" "test_task_list" {
 func testAccTaskListDataSourceConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_task_list" "test_task_list" {
-  name        = "test-task-list-%s"
+  name        = "tf-acc-task-list-%s"
   description = "test-description-%s"
 
   task_list_items {
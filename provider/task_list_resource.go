@@ -2,11 +2,10 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +17,9 @@ func resourceTaskList() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantTaskList,
 		ReadContext:   readResourceFireHydrantTaskList,
 		DeleteContext: deleteResourceFireHydrantTaskList,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: taskListImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -67,7 +67,7 @@ func readResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceData
 	})
 	taskListResponse, err := firehydrantAPIClient.TaskLists().Get(ctx, taskListID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Task list %s no longer exists", taskListID), map[string]interface{}{
 				"id": taskListID,
 			})
@@ -184,7 +184,7 @@ func deleteResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceDa
 	})
 	err := firehydrantAPIClient.TaskLists().Delete(ctx, taskListID)
 	if err != nil {
-		if errors.Is(err, firehydrant.ErrorNotFound) {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting task list %s: %v", taskListID, err)
@@ -192,3 +192,24 @@ func deleteResourceFireHydrantTaskList(ctx context.Context, d *schema.ResourceDa
 
 	return diag.Diagnostics{}
 }
+
+var taskListImportLookup = importLookup{
+	resourceName: "task list",
+	fields:       []string{"name"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := firehydrant.TaskListQuery{Query: value, PerPage: importLookupPerPage}
+		return listImportCandidates(ctx, client, &request,
+			func(request *firehydrant.TaskListQuery, page *int) { request.Page = *page },
+			func(ctx context.Context, request *firehydrant.TaskListQuery) (pagination.PaginateResponse[firehydrant.TaskListResponse], error) {
+				response, err := client.TaskLists().List(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				return pagination.RESTPage[firehydrant.TaskListResponse]{Data: response.TaskLists, Pagination: response.Pagination}, nil
+			},
+			func(taskList firehydrant.TaskListResponse) importCandidate {
+				return importCandidate{ID: taskList.ID, Name: taskList.Name}
+			},
+		)
+	},
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -15,9 +16,9 @@ func TestAccTaskListResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTaskListResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTaskListResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListResourceConfig_basic(rName),
@@ -25,7 +26,7 @@ func TestAccTaskListResource_basic(t *testing.T) {
 					testAccCheckTaskListResourceExistsWithAttributes_basic("firehydrant_task_list.test_task_list"),
 					resource.TestCheckResourceAttrSet("firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rName)),
+						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_task_list.test_task_list", "task_list_items.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -42,9 +43,9 @@ func TestAccTaskListResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTaskListResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTaskListResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListResourceConfig_basic(rName),
@@ -52,7 +53,7 @@ func TestAccTaskListResource_update(t *testing.T) {
 					testAccCheckTaskListResourceExistsWithAttributes_basic("firehydrant_task_list.test_task_list"),
 					resource.TestCheckResourceAttrSet("firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rName)),
+						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rName)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_task_list.test_task_list", "task_list_items.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -65,7 +66,7 @@ func TestAccTaskListResource_update(t *testing.T) {
 					testAccCheckTaskListResourceExistsWithAttributes_update("firehydrant_task_list.test_task_list"),
 					resource.TestCheckResourceAttrSet("firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rNameUpdated)),
+						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_task_list.test_task_list", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
@@ -86,7 +87,7 @@ func TestAccTaskListResource_update(t *testing.T) {
 					testAccCheckTaskListResourceExistsWithAttributes_basic("firehydrant_task_list.test_task_list"),
 					resource.TestCheckResourceAttrSet("firehydrant_task_list.test_task_list", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("test-task-list-%s", rNameUpdated)),
+						"firehydrant_task_list.test_task_list", "name", fmt.Sprintf("tf-acc-task-list-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_task_list.test_task_list", "task_list_items.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -102,8 +103,8 @@ func TestAccTaskListResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListResourceConfig_basic(rName),
@@ -122,8 +123,8 @@ func TestAccTaskListResourceImport_allAttributes(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskListResourceConfig_update(rName),
@@ -137,6 +138,35 @@ func TestAccTaskListResourceImport_allAttributes(t *testing.T) {
 	})
 }
 
+func TestOfflineTaskListResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	testFakeAPICase(t, server, "firehydrant_task_list", "/v1/task_lists", []resource.TestStep{
+		{
+			Config: testAccTaskListResourceConfig_basic("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_task_list.test_task_list", "id"),
+				resource.TestCheckResourceAttr("firehydrant_task_list.test_task_list", "task_list_items.#", "1"),
+			),
+		},
+		{
+			Config: testAccTaskListResourceConfig_update("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_task_list.test_task_list", "description", "test-description-offline"),
+				resource.TestCheckResourceAttr("firehydrant_task_list.test_task_list", "task_list_items.#", "2"),
+				resource.TestCheckResourceAttr("firehydrant_task_list.test_task_list", "task_list_items.1.summary", "test-summary2-offline"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_task_list.test_task_list",
+			ImportStateId:     "name=tf-acc-task-list-offline",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccCheckTaskListResourceExistsWithAttributes_basic(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		taskListResource, ok := s.RootModule().Resources[resourceName]
@@ -263,7 +293,7 @@ func testAccCheckTaskListResourceDestroy() resource.TestCheckFunc {
 func testAccTaskListResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_task_list" "test_task_list" {
-  name = "test-task-list-%s"
+  name = "tf-acc-task-list-%s"
 
   task_list_items {
     summary = "test-summary1-%s"
@@ -274,7 +304,7 @@ resource "firehydrant_task_list" "test_task_list" {
 func testAccTaskListResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_task_list" "test_task_list" {
-  name        = "test-task-list-%s"
+  name        = "tf-acc-task-list-%s"
   description = "test-description-%s"
 
   task_list_items {
@@ -76,6 +76,8 @@ func dataFireHydrantTeam(ctx context.Context, d *schema.ResourceData, m interfac
 	// Get the API client
 	client := m.(*firehydrant.APIClient)
 
+	ctx = firehydrant.WithCachedReads(ctx)
+
 	// Get the team
 	id := d.Get("id").(string)
 	tflog.Debug(ctx, fmt.Sprintf("Read team: %s", id), map[string]interface{}{
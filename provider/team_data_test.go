@@ -49,8 +49,8 @@ func TestTeamDataSource_OneMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testTeamDataSourceConfig_basic(),
@@ -95,8 +95,8 @@ func TestTeamDataSource_NoMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testTeamDataSourceConfig_basic(),
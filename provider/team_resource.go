@@ -5,9 +5,10 @@ import (
 	"fmt"
 
 	"github.com/firehydrant/firehydrant-go-sdk/models/components"
-	"github.com/firehydrant/firehydrant-go-sdk/models/sdkerrors"
+	"github.com/firehydrant/firehydrant-go-sdk/models/operations"
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/ptr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +20,9 @@ func resourceTeam() *schema.Resource {
 		UpdateContext: updateResourceFireHydrantTeam,
 		ReadContext:   readResourceFireHydrantTeam,
 		DeleteContext: deleteResourceFireHydrantTeam,
+		Timeouts:      defaultResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: teamImportLookup.importState,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -74,7 +76,7 @@ func readResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData, m
 	})
 	teamResponse, err := client.Sdk.Teams.GetTeam(ctx, teamID, nil)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Team %s no longer exists", teamID), map[string]interface{}{
 				"id": teamID,
 			})
@@ -240,7 +242,7 @@ func deleteResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 	})
 	err := client.Sdk.Teams.DeleteTeam(ctx, teamID)
 	if err != nil {
-		if sdkErr, ok := err.(*sdkerrors.SDKError); ok && sdkErr.StatusCode == 404 {
+		if firehydrant.IsNotFound(err) {
 			return nil
 		}
 		return diag.Errorf("Error deleting team %s: %v", teamID, err)
@@ -248,3 +250,23 @@ func deleteResourceFireHydrantTeam(ctx context.Context, d *schema.ResourceData,
 
 	return diag.Diagnostics{}
 }
+
+var teamImportLookup = importLookup{
+	resourceName: "team",
+	fields:       []string{"name", "slug"},
+	list: func(ctx context.Context, client *firehydrant.APIClient, field, value string) ([]importCandidate, error) {
+		request := operations.ListTeamsRequest{PerPage: ptr.Of(importLookupPerPage)}
+		if field == "name" {
+			request.Name = &value
+		}
+		return listImportCandidates(ctx, client, &request,
+			func(request *operations.ListTeamsRequest, page *int) { request.Page = page },
+			func(ctx context.Context, request *operations.ListTeamsRequest) (pagination.PaginateResponse[components.TeamEntity], error) {
+				return client.Sdk.Teams.ListTeams(ctx, *request)
+			},
+			func(team components.TeamEntity) importCandidate {
+				return importCandidate{ID: stringValue(team.GetID()), Name: stringValue(team.GetName()), Slug: stringValue(team.GetSlug())}
+			},
+		)
+	},
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,9 +17,9 @@ func TestAccTeamResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTeamResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTeamResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamResourceConfig_basic(rName),
@@ -26,7 +27,7 @@ func TestAccTeamResource_basic(t *testing.T) {
 					testAccCheckTeamResourceExistsWithAttributes_basic("firehydrant_team.test_team"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_team.test_team", "name", fmt.Sprintf("test-team-%s", rName)),
+						"firehydrant_team.test_team", "name", fmt.Sprintf("tf-acc-team-%s", rName)),
 				),
 			},
 		},
@@ -39,9 +40,9 @@ func TestAccTeamResource_update(t *testing.T) {
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTeamResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTeamResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamResourceConfig_basic(rName),
@@ -49,7 +50,7 @@ func TestAccTeamResource_update(t *testing.T) {
 					testAccCheckTeamResourceExistsWithAttributes_basic("firehydrant_team.test_team"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_team.test_team", "name", fmt.Sprintf("test-team-%s", rName)),
+						"firehydrant_team.test_team", "name", fmt.Sprintf("tf-acc-team-%s", rName)),
 				),
 			},
 			{
@@ -58,7 +59,7 @@ func TestAccTeamResource_update(t *testing.T) {
 					testAccCheckTeamResourceExistsWithAttributes_update("firehydrant_team.test_team"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_team.test_team", "name", fmt.Sprintf("test-team-%s", rNameUpdated)),
+						"firehydrant_team.test_team", "name", fmt.Sprintf("tf-acc-team-%s", rNameUpdated)),
 					resource.TestCheckResourceAttr(
 						"firehydrant_team.test_team", "description", fmt.Sprintf("test-description-%s", rNameUpdated)),
 				),
@@ -69,7 +70,7 @@ func TestAccTeamResource_update(t *testing.T) {
 					testAccCheckTeamResourceExistsWithAttributes_basic("firehydrant_team.test_team"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_team.test_team", "name", fmt.Sprintf("test-team-%s", rNameUpdated)),
+						"firehydrant_team.test_team", "name", fmt.Sprintf("tf-acc-team-%s", rNameUpdated)),
 				),
 			},
 		},
@@ -81,8 +82,8 @@ func TestAccTeamResourceImport_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamResourceConfig_basic(rName),
@@ -108,9 +109,9 @@ func TestAccTeamResource_withMembership(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTeamResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTeamResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamResourceConfig_withMembership(rName, existingUser),
@@ -118,7 +119,7 @@ func TestAccTeamResource_withMembership(t *testing.T) {
 					testAccCheckTeamResourceExistsWithAttributes_basic("firehydrant_team.test_team"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
 					resource.TestCheckResourceAttr(
-						"firehydrant_team.test_team", "name", fmt.Sprintf("test-team-%s", rName)),
+						"firehydrant_team.test_team", "name", fmt.Sprintf("tf-acc-team-%s", rName)),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "memberships.0.user_id"),
 					resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "memberships.0.default_incident_role_id"),
 				),
@@ -220,17 +221,45 @@ func testAccCheckTeamResourceDestroy() resource.TestCheckFunc {
 	}
 }
 
+func TestOfflineTeamResource(t *testing.T) {
+	server := fakeapi.NewServer()
+	defer server.Close()
+
+	testFakeAPICase(t, server, "firehydrant_team", "/v1/teams", []resource.TestStep{
+		{
+			Config: testAccTeamResourceConfig_basic("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("firehydrant_team.test_team", "id"),
+				resource.TestCheckResourceAttr("firehydrant_team.test_team", "name", "tf-acc-team-offline"),
+				resource.TestCheckResourceAttr("firehydrant_team.test_team", "slug", "tf-acc-team-offline"),
+			),
+		},
+		{
+			Config: testAccTeamResourceConfig_update("offline"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("firehydrant_team.test_team", "description", "test-description-offline"),
+			),
+		},
+		{
+			ResourceName:      "firehydrant_team.test_team",
+			ImportStateId:     "slug=tf-acc-team-offline",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	})
+}
+
 func testAccTeamResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team" {
-  name = "test-team-%s"
+  name = "tf-acc-team-%s"
 }`, rName)
 }
 
 func testAccTeamResourceConfig_update(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team" {
-  name        = "test-team-%s"
+  name        = "tf-acc-team-%s"
   description = "test-description-%s"
 }`, rName, rName)
 }
@@ -238,7 +267,7 @@ resource "firehydrant_team" "test_team" {
 func testAccTeamResourceConfig_withMembership(rName string, userEmail string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_incident_role" "test_incident_role" {
-	name    = "test-incident-role-%s"
+	name    = "tf-acc-incident-role-%s"
 	summary = "test-summary-%s"
 }
 
@@ -247,7 +276,7 @@ data "firehydrant_user" "test_user" {
 }
 
 resource "firehydrant_team" "test_team" {
-	name = "test-team-%s"
+	name = "tf-acc-team-%s"
 
 	memberships {
 		user_id                  = data.firehydrant_user.test_user.id
@@ -39,6 +39,8 @@ func dataFireHydrantTeams(ctx context.Context, d *schema.ResourceData, m interfa
 	// Get the API client
 	client := m.(*firehydrant.APIClient)
 
+	ctx = firehydrant.WithCachedReads(ctx)
+
 	// Build the list teams request
 	query := d.Get("query").(string)
 	tflog.Debug(ctx, "Read teams", map[string]interface{}{
@@ -65,8 +67,6 @@ func dataFireHydrantTeams(ctx context.Context, d *schema.ResourceData, m interfa
 			}
 			return response, nil
 		},
-		// This API has strict rate limiting. This delay will keep us under the limit.
-		GetPageDelay: time.Millisecond * 550,
 	}
 	teamsResponse, err := pagination.Paginate(ctx, opts)
 	if err != nil {
@@ -17,8 +17,8 @@ import (
 func TestAccTeamsDataSource_QueryMatch(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamsDataSourceConfig_Query(),
@@ -112,8 +112,8 @@ func TestTeamsDataSource_Pagination(t *testing.T) {
 	t.Setenv("FIREHYDRANT_BASE_URL", server.URL)
 
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamsDataSourceConfig_pagination(),
@@ -140,9 +140,9 @@ func TestAccTeamsDataSource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: sharedProviderFactories(),
-		CheckDestroy:      testAccCheckTeamResourceDestroy(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: sharedProviderFactories(),
+		CheckDestroy:             testAccCheckTeamResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTeamsDataSourceConfig_basic(rName),
@@ -195,7 +195,7 @@ data "firehydrant_teams" "test_teams" {
 func testAccTeamsDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "firehydrant_team" "test_team" {
-  name = "test-team-%s"
+  name = "tf-acc-team-%s"
 }
 
 data "firehydrant_teams" "all_teams" {
@@ -4,13 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -71,35 +73,28 @@ func getAccTestClient() (*firehydrant.APIClient, error) {
 
 // sharedProviderFactories returns provider factories that use a shared provider instance.
 // This should be used for acceptance tests to reduce API calls and prevent rate limiting.
-func sharedProviderFactories() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"firehydrant": func() (*schema.Provider, error) {
+func sharedProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"firehydrant": func() (tfprotov5.ProviderServer, error) {
 			client, err := getAccTestClient()
 			if err != nil {
 				return nil, err
 			}
 
-			// Return provider with pre-configured client
-			p := Provider()
-			p.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
-				// Skip normal setup, return shared client
-				return client, nil
-			}
-
-			return p, nil
+			// Return provider with pre-configured client, skipping normal setup
+			return newServer(context.Background(), &configuredClient{fixed: client})
 		},
 	}
 }
 
 // mockProviderFactories returns provider factories that create a new provider instance for each test.
 // This should be used for tests that need to mock the API or use different configurations.
-func mockProviderFactories() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"firehydrant": func() (*schema.Provider, error) {
+func mockProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"firehydrant": func() (tfprotov5.ProviderServer, error) {
 			// Create a new provider instance for mock tests
 			// This bypasses the shared provider and allows environment variable changes
-			p := Provider()
-			return p, nil
+			return NewServer(context.Background())
 		},
 	}
 }
@@ -388,3 +383,217 @@ func getSharedServiceID2(t *testing.T) string {
 	t.Fatalf("Shared service 'service2' not available")
 	return ""
 }
+
+// defaultProviderFactories serves the provider to acceptance and offline tests the way Terraform
+// does, through the mux server.
+func defaultProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"firehydrant": func() (tfprotov5.ProviderServer, error) {
+			return NewServer(context.Background())
+		},
+	}
+}
+
+// testFakeAPIProviderConfig returns a provider block pointing the provider at an in-memory
+// fake API, for offline tests run with resource.UnitTest.
+func testFakeAPIProviderConfig(server *fakeapi.Server) string {
+	return fmt.Sprintf(`
+provider "firehydrant" {
+  api_key              = "test-token-very-authorized"
+  firehydrant_base_url = %q
+}
+`, server.BaseURL())
+}
+
+// testFakeAPICase runs steps offline against server and checks that every resourceType object
+// was deleted from it afterwards. Steps with a config get the provider block for server
+// prepended. collectionPath is the API path the objects live under, e.g. /v1/services; a
+// {team_id} in it is replaced with the team_id attribute of team scoped objects.
+func testFakeAPICase(t *testing.T, server *fakeapi.Server, resourceType, collectionPath string, steps []resource.TestStep) {
+	t.Helper()
+	for i := range steps {
+		if steps[i].Config != "" {
+			steps[i].Config = testFakeAPIProviderConfig(server) + steps[i].Config
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: defaultProviderFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, stateResource := range s.RootModule().Resources {
+				if stateResource.Type != resourceType {
+					continue
+				}
+
+				path := strings.ReplaceAll(collectionPath, "{team_id}", stateResource.Primary.Attributes["team_id"])
+				if _, ok := server.Get(path + "/" + stateResource.Primary.ID); ok {
+					return fmt.Errorf("%s %s still exists", resourceType, stateResource.Primary.ID)
+				}
+			}
+
+			return nil
+		},
+		Steps: steps,
+	})
+}
+
+// testFakeAPIImportStateIDForTeam returns the Team_ID:ID import ID of a team scoped resource
+func testFakeAPIImportStateIDForTeam(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+	}
+}
+
+// testCallFunction calls a provider function through the provider server and returns its
+// result
+func testCallFunction(t *testing.T, name string, arguments ...tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+	t.Helper()
+	ctx := context.Background()
+	server, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Like Terraform, the schema is read first, which tells the mux server which provider
+	// implements the function
+	schema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	definition, ok := schema.Functions[name]
+	if !ok {
+		t.Fatalf("function %s is not defined", name)
+	}
+
+	values := make([]*tfprotov5.DynamicValue, len(arguments))
+	for i, argument := range arguments {
+		value, err := tfprotov5.NewDynamicValue(argument.Type(), argument)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values[i] = &value
+	}
+	resp, err := server.CallFunction(ctx, &tfprotov5.CallFunctionRequest{Name: name, Arguments: values})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	result, err := resp.Result.Unmarshal(definition.Return.Type)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result, nil
+}
+
+// testOpenEphemeralResource opens an ephemeral resource through the provider server, which is
+// configured against baseURL. Attributes missing from config are null.
+func testOpenEphemeralResource(t *testing.T, baseURL, typeName string, config map[string]tftypes.Value) (tftypes.Value, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	server, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceSchema, ok := schema.EphemeralResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("ephemeral resource %s is not defined", typeName)
+	}
+
+	providerConfig := testDynamicValue(t, schema.Provider, map[string]tftypes.Value{
+		apiKeyName:             tftypes.NewValue(tftypes.String, "test-token-very-authorized"),
+		firehydrantBaseURLName: tftypes.NewValue(tftypes.String, baseURL),
+		maxRetriesName:         tftypes.NewValue(tftypes.Number, 0),
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("configuring the provider: %s: %s", d.Summary, d.Detail)
+	}
+
+	resp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   testDynamicValue(t, resourceSchema, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Result == nil {
+		return tftypes.Value{}, resp.Diagnostics
+	}
+	result, err := resp.Result.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result, resp.Diagnostics
+}
+
+// testDynamicValue builds a configuration object for schema from values, leaving the other
+// attributes null
+func testDynamicValue(t *testing.T, schema *tfprotov5.Schema, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+	objectType := schema.ValueType().(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	value, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+// testUpgradeResourceState upgrades state of the given schema version, as JSON, through the
+// provider server and returns the attributes of the upgraded state
+func testUpgradeResourceState(t *testing.T, typeName string, version int64, state string) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	server, err := NewServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceSchema, ok := schema.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("resource %s is not defined", typeName)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov5.RawState{JSON: []byte(state)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+	upgraded, err := resp.UpgradedState.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := upgraded.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	return attributes
+}
@@ -50,6 +50,11 @@ type CreatedResources struct {
 	ServiceIDs        []string `json:"service_ids"`
 }
 
+// sharedTestResourcePrefix starts the names of the shared resources a test run creates. It
+// extends the tf-acc- prefix of the objects tests create, but the sweepers leave these alone
+// since other test runs may still be using them.
+const sharedTestResourcePrefix = "tf-acc-shared-"
+
 var (
 	sharedTestResourcesInstance *SharedTestResources
 	sharedTestResourcesOnce     sync.Once
@@ -104,7 +109,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create default team if not in env
 	if len(r.Teams) == 0 {
-		teamID, err := r.createSharedTeam(ctx, client, fmt.Sprintf("tf-test-shared-default-%s", runID))
+		teamID, err := r.createSharedTeam(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"default-%s", runID))
 		if err != nil {
 			return fmt.Errorf("could not create shared team: %w", err)
 		}
@@ -114,7 +119,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create default on-call schedule if not in env
 	if len(r.OnCallSchedules) == 0 {
-		scheduleID, err := r.createSharedOnCallSchedule(ctx, client, fmt.Sprintf("tf-test-shared-schedule-%s", runID), r.Teams["default"])
+		scheduleID, err := r.createSharedOnCallSchedule(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"schedule-%s", runID), r.Teams["default"])
 		if err != nil {
 			return fmt.Errorf("could not create shared on-call schedule: %w", err)
 		}
@@ -124,7 +129,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create default incident role if not in env
 	if len(r.IncidentRoles) == 0 {
-		roleID, err := r.createSharedIncidentRole(ctx, client, fmt.Sprintf("tf-test-shared-role-%s", runID))
+		roleID, err := r.createSharedIncidentRole(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"role-%s", runID))
 		if err != nil {
 			return fmt.Errorf("could not create shared incident role: %w", err)
 		}
@@ -134,7 +139,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create default service if not in env
 	if len(r.Services) == 0 {
-		serviceID, err := r.createSharedService(ctx, client, fmt.Sprintf("tf-test-shared-service-%s", runID))
+		serviceID, err := r.createSharedService(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"service-%s", runID))
 		if err != nil {
 			return fmt.Errorf("could not create shared service: %w", err)
 		}
@@ -144,7 +149,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create second shared service for dependency tests
 	if _, exists := r.Services["service2"]; !exists {
-		serviceID, err := r.createSharedService(ctx, client, fmt.Sprintf("tf-test-shared-service-2-%s", runID))
+		serviceID, err := r.createSharedService(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"service-2-%s", runID))
 		if err != nil {
 			return fmt.Errorf("could not create shared service 2: %w", err)
 		}
@@ -154,7 +159,7 @@ func (r *SharedTestResources) InitializeSharedResources(ctx context.Context, cli
 
 	// Create second shared team for tests that need multiple teams
 	if _, exists := r.Teams["team2"]; !exists {
-		teamID, err := r.createSharedTeam(ctx, client, fmt.Sprintf("tf-test-shared-team2-%s", runID))
+		teamID, err := r.createSharedTeam(ctx, client, fmt.Sprintf(sharedTestResourcePrefix+"team2-%s", runID))
 		if err != nil {
 			return fmt.Errorf("could not create shared team 2: %w", err)
 		}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// defaultResourceTimeouts are the timeouts every resource declares, so a hung API call
+// can't block Terraform forever and slow operations can be given more time with a
+// timeouts block. The SDK bounds the context passed to each CRUD function by these, and
+// the API client stops retrying once that context's deadline would pass.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultCreateTimeout),
+		Read:   schema.DefaultTimeout(defaultReadTimeout),
+		Update: schema.DefaultTimeout(defaultUpdateTimeout),
+		Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+	}
+}
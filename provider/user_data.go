@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant"
+	"github.com/firehydrant/terraform-provider-firehydrant/provider/internal/pagination"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -38,6 +39,8 @@ func dataFireHydrantUser(ctx context.Context, d *schema.ResourceData, m interfac
 	// Get the API client
 	firehydrantAPIClient := m.(firehydrant.Client)
 
+	ctx = firehydrant.WithCachedReads(ctx)
+
 	// Get the user
 	email := d.Get("email").(string)
 	tflog.Debug(ctx, fmt.Sprintf("Fetch user: %s", email), map[string]interface{}{
@@ -45,22 +48,34 @@ func dataFireHydrantUser(ctx context.Context, d *schema.ResourceData, m interfac
 	})
 
 	params := firehydrant.GetUserParams{Query: email}
-	userResponse, err := firehydrantAPIClient.GetUsers(ctx, params)
-	if err != nil {
-		return diag.Errorf("Error fetching user '%s': %v", email, err)
+	users, diags := pagination.Paginate(ctx, pagination.PaginateRequestOptions[firehydrant.GetUserParams, firehydrant.User]{
+		Request: &params,
+		SetRequestPageFunc: func(params *firehydrant.GetUserParams, page *int) {
+			params.Page = *page
+		},
+		GetPageFunc: func(ctx context.Context, _ *firehydrant.APIClient, params *firehydrant.GetUserParams) (pagination.PaginateResponse[firehydrant.User], diag.Diagnostics) {
+			response, err := firehydrantAPIClient.GetUsers(ctx, *params)
+			if err != nil {
+				return nil, diag.Errorf("Error fetching user '%s': %v", email, err)
+			}
+			return pagination.RESTPage[firehydrant.User]{Data: response.Users, Pagination: response.Pagination}, nil
+		},
+	})
+	if diags.HasError() {
+		return diags
 	}
 
-	if len(userResponse.Users) == 0 {
+	if len(users) == 0 {
 		return diag.Errorf("Did not find user matching '%s'", email)
 	}
-	if len(userResponse.Users) > 1 {
+	if len(users) > 1 {
 		return diag.Errorf("Found multiple matching users for '%s'", email)
 	}
 
 	// Gather values from API response
 	attributes := map[string]interface{}{
-		"id":   userResponse.Users[0].ID,
-		"name": userResponse.Users[0].Name,
+		"id":   users[0].ID,
+		"name": users[0].Name,
 	}
 
 	// Set the data source attributes to the values we got from the API
@@ -71,7 +86,7 @@ func dataFireHydrantUser(ctx context.Context, d *schema.ResourceData, m interfac
 	}
 
 	// Set the user's ID in state
-	d.SetId(userResponse.Users[0].ID)
+	d.SetId(users[0].ID)
 
 	return diag.Diagnostics{}
 }
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/firehydrant/terraform-provider-firehydrant/firehydrant/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -40,8 +41,8 @@ func TestUserDataSource_OneMatch(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testUserDataSourceConfig_basic(),
@@ -81,8 +82,8 @@ func TestUserDataSource_MultipleMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testUserDataSourceConfig_basic(),
@@ -114,8 +115,8 @@ func TestUserDataSource_NoMatches(t *testing.T) {
 	t.Cleanup(func() { os.Setenv("FIREHYDRANT_BASE_URL", orig) })
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testFireHydrantIsSetup(t) },
-		ProviderFactories: mockProviderFactories(),
+		PreCheck:                 func() { testFireHydrantIsSetup(t) },
+		ProtoV5ProviderFactories: mockProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config:      testUserDataSourceConfig_basic(),
@@ -124,3 +125,47 @@ func TestUserDataSource_NoMatches(t *testing.T) {
 		},
 	})
 }
+
+func TestOfflineUserDataSource_ReadCache(t *testing.T) {
+	// requests runs the same plans with and without the read cache and returns how many
+	// user lookups reached the API
+	requests := func(readCache bool) int {
+		server := fakeapi.NewServer()
+		defer server.Close()
+		server.Seed("/v1/users", map[string]interface{}{"name": "Test User", "email": "test-user@firehydrant.io"})
+
+		config := fmt.Sprintf(`
+provider "firehydrant" {
+  api_key              = "test-token-very-authorized"
+  firehydrant_base_url = %q
+  read_cache           = %t
+}
+`, server.BaseURL(), readCache)
+		for i := 0; i < 5; i++ {
+			config += fmt.Sprintf(`
+data "firehydrant_user" "test_user_%d" {
+  email = "test-user@firehydrant.io"
+}
+`, i)
+		}
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV5ProviderFactories: defaultProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.firehydrant_user.test_user_0", "name", "Test User"),
+						resource.TestCheckResourceAttr("data.firehydrant_user.test_user_4", "name", "Test User"),
+					),
+				},
+			},
+		})
+		return server.Requests("GET", "/v1/users")
+	}
+
+	uncached, cached := requests(false), requests(true)
+	if cached == 0 || uncached != 5*cached {
+		t.Fatalf("Expected the read cache to send each lookup once per provider instead of 5 times, got %d requests with it and %d without", cached, uncached)
+	}
+}